@@ -0,0 +1,56 @@
+package gimei
+
+// NewNames return n new instances of person. It takes the lock only once, so
+// it is faster than calling NewName n times. It return empty slice if n <= 0.
+func NewNames(n int) []*Name {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return global().names(n)
+}
+
+// NewAddresses return n new instances of address. See NewNames.
+func NewAddresses(n int) []*Address {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return global().addresses(n)
+}
+
+// NewNames return n new instances of person.
+func (g *Generator) NewNames(n int) []*Name {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().names(n)
+}
+
+// NewAddresses return n new instances of address.
+func (g *Generator) NewAddresses(n int) []*Address {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().addresses(n)
+}
+
+func (p picker) names(n int) []*Name {
+	if n <= 0 {
+		return []*Name{}
+	}
+	list := make([]*Name, n)
+	for i := range list {
+		list[i] = p.name()
+	}
+	return list
+}
+
+func (p picker) addresses(n int) []*Address {
+	if n <= 0 {
+		return []*Address{}
+	}
+	list := make([]*Address, n)
+	for i := range list {
+		list[i] = p.address()
+	}
+	return list
+}
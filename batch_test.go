@@ -0,0 +1,98 @@
+package gimei_test
+
+import (
+	"math/rand"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestNewNames(t *testing.T) {
+	defer gimei.SetRandom(rand.New(rand.NewSource(rand.Int63())))
+
+	gimei.SetRandom(rand.New(rand.NewSource(42)))
+	var want []string
+	for i := 0; i < 10; i++ {
+		want = append(want, gimei.NewName().Kanji())
+	}
+	for i := 0; i < 10; i++ {
+		want = append(want, gimei.NewAddress().Kanji())
+	}
+
+	gimei.SetRandom(rand.New(rand.NewSource(42)))
+	var got []string
+	for _, n := range gimei.NewNames(10) {
+		got = append(got, n.Kanji())
+	}
+	for _, a := range gimei.NewAddresses(10) {
+		got = append(got, a.Kanji())
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got[%d] == %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	g1 := gimei.NewGenerator(rand.New(rand.NewSource(42)))
+	g2 := gimei.NewGenerator(rand.New(rand.NewSource(42)))
+	names := g1.NewNames(5)
+	addresses := g1.NewAddresses(5)
+	for i := 0; i < 5; i++ {
+		if n := g2.NewName(); n.Kanji() != names[i].Kanji() {
+			t.Errorf("want %q but %q", names[i], n)
+		}
+	}
+	for i := 0; i < 5; i++ {
+		if a := g2.NewAddress(); a.Kanji() != addresses[i].Kanji() {
+			t.Errorf("want %q but %q", addresses[i], a)
+		}
+	}
+
+	for _, n := range []int{0, -1} {
+		if got := gimei.NewNames(n); got == nil || len(got) != 0 {
+			t.Errorf("NewNames(%d) should be empty but %v", n, got)
+		}
+		if got := g1.NewAddresses(n); got == nil || len(got) != 0 {
+			t.Errorf("NewAddresses(%d) should be empty but %v", n, got)
+		}
+	}
+}
+
+func BenchmarkNewName(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		gimei.NewName()
+	}
+}
+
+func BenchmarkNewNames(b *testing.B) {
+	gimei.NewNames(b.N)
+}
+
+func BenchmarkNewNameParallel(b *testing.B) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			gimei.NewName()
+		}
+	})
+}
+
+func BenchmarkGeneratorParallel(b *testing.B) {
+	var seed int64
+	b.RunParallel(func(pb *testing.PB) {
+		// a generator per goroutine, seeded for reproducibility
+		g := gimei.NewGenerator(rand.New(rand.NewSource(atomic.AddInt64(&seed, 1))))
+		for pb.Next() {
+			g.NewName()
+		}
+	})
+}
+
+func BenchmarkNameForIndexParallel(b *testing.B) {
+	var next int64
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			gimei.NameForIndex(42, int(atomic.AddInt64(&next, 1)))
+		}
+	})
+}
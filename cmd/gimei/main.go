@@ -1,7 +1,7 @@
 package main
 
 import (
-	"encoding/json"
+	"bufio"
 	"flag"
 	"fmt"
 	"os"
@@ -18,6 +18,12 @@ func doName(name *gimei.Name, arg string) string {
 		return name.Hiragana() // さいとう はるな
 	case "katakana":
 		return name.Katakana() // サイトウ ハルナ
+	case "ruby":
+		return name.Ruby() // <ruby>斎藤<rt>さいとう</rt></ruby> <ruby>陽菜<rt>はるな</rt></ruby>
+	case "ruby-katakana":
+		return name.Ruby(gimei.RubyKatakana) // <ruby>斎藤<rt>サイトウ</rt></ruby> <ruby>陽菜<rt>ハルナ</rt></ruby>
+	case "ruby-plain":
+		return name.Ruby(gimei.RubyPlain) // 斎藤(さいとう) 陽菜(はるな)
 	case "romaji":
 		return name.Romaji() // Haruna Saito
 	case "last-name":
@@ -44,7 +50,16 @@ func doName(name *gimei.Name, arg string) string {
 		return fmt.Sprint(name.IsMale()) // false
 	case "is-female":
 		return fmt.Sprint(name.IsFemale()) // false
+	case "is-neutral":
+		return fmt.Sprint(name.IsNeutral()) // false
+	case "sex":
+		return name.Sex.String() // 女
+	case "sex-code":
+		return fmt.Sprint(name.Sex.ISO5218()) // 2
 	default:
+		if strings.HasPrefix(arg, "format=") {
+			return name.Format(strings.TrimPrefix(arg, "format=")) // 斎藤　陽菜 様
+		}
 		return name.String() // 斎藤 陽菜
 	}
 }
@@ -57,6 +72,12 @@ func doAddress(address *gimei.Address, arg string) string {
 		return address.Hiragana() // おかやまけんおおしまぐんやまとそんいなぎちょう
 	case "katakana":
 		return address.Katakana() // オカヤマケンオオシマグンヤマトソンイナギチョウ
+	case "ruby":
+		return address.Ruby() // <ruby>岡山県<rt>おかやまけん</rt></ruby>...
+	case "ruby-katakana":
+		return address.Ruby(gimei.RubyKatakana) // <ruby>岡山県<rt>オカヤマケン</rt></ruby>...
+	case "ruby-plain":
+		return address.Ruby(gimei.RubyPlain) // 岡山県(おかやまけん)大島郡大和村(おおしまぐんやまとそん)稲木町(いなぎちょう)
 	case "prefecture-name":
 		return address.Prefecture.String() // 岡山県
 	case "prefecture-kanji":
@@ -65,6 +86,12 @@ func doAddress(address *gimei.Address, arg string) string {
 		return address.Prefecture.Hiragana() // おかやまけん
 	case "prefecture-katakana":
 		return address.Prefecture.Katakana() // オカヤマケン
+	case "prefecture-code":
+		return address.PrefectureCode() // 33
+	case "region":
+		return address.Region().String() // 中国
+	case "location":
+		return address.Location().String() // 34.6618,133.9344
 	case "city-name":
 		return address.City.String() // 大島郡大和村
 	case "city-kanji":
@@ -73,6 +100,32 @@ func doAddress(address *gimei.Address, arg string) string {
 		return address.City.Hiragana() // おおしまぐんやまとそん
 	case "city-katakana":
 		return address.City.Katakana() // オオシマグンヤマトソン
+	case "county-name":
+		return address.County().String() // 大島郡
+	case "county-kanji":
+		return address.County().Kanji() // 大島郡
+	case "county-hiragana":
+		return address.County().Hiragana() // おおしまぐん
+	case "county-katakana":
+		return address.County().Katakana() // オオシマグン
+	case "municipality-name":
+		return address.Municipality().String() // 大和村
+	case "municipality-kanji":
+		return address.Municipality().Kanji() // 大和村
+	case "municipality-hiragana":
+		return address.Municipality().Hiragana() // やまとそん
+	case "municipality-katakana":
+		return address.Municipality().Katakana() // ヤマトソン
+	case "municipality-code":
+		return address.MunicipalityCode() // 131016
+	case "ward-name":
+		return address.Ward().String() // 中央区
+	case "ward-kanji":
+		return address.Ward().Kanji() // 中央区
+	case "ward-hiragana":
+		return address.Ward().Hiragana() // ちゅうおうく
+	case "ward-katakana":
+		return address.Ward().Katakana() // チュウオウク
 	case "town-name":
 		return address.Town.String() // 稲木町
 	case "town-kanji":
@@ -103,18 +156,25 @@ const (
 var revision = "HEAD"
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "mask" {
+		os.Exit(runMask(os.Args[2:]))
+	}
+
 	var sep string
 	var count bool
 	var jsonOutput bool
 	var showVersion bool
+	var weighted bool
 	var n int
 	flag.IntVar(&n, "n", 1, "N records")
 	flag.StringVar(&sep, "sep", ", ", "separator")
 	flag.BoolVar(&count, "count", false, "")
 	flag.BoolVar(&jsonOutput, "json", false, "output as JSON array")
+	flag.BoolVar(&weighted, "weighted", false, "pick address weighted by population")
 	flag.BoolVar(&showVersion, "v", false, "show version")
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, `Usage: gimei [OPTIONS] [ARGS]
+       gimei mask -in FILE -columns COLUMN=ARG[,COLUMN=ARG...]
 
   -sep string
         specify string used to separate fields(default: ", ")
@@ -124,16 +184,21 @@ func main() {
         display records read from embedded yaml files and exit
   -json
         output as JSON array
+  -weighted
+        pick address weighted by population
   -h, -help
         display this usage and exit
   -v
         show version
 
-  Arguments for name/male/female:
+  Arguments for name/male/female/neutral:
     name
     kanji
     hiragana
     katakana
+    ruby
+    ruby-katakana
+    ruby-plain
     romaji
     last-name
     last-kanji
@@ -147,20 +212,43 @@ func main() {
     first-romaji
     is-male
     is-female
+    is-neutral
+    sex
+    sex-code
+    format=LAYOUT
   
   Arguments for address:
     name
     kanji
     hiragana
     katakana
+    ruby
+    ruby-katakana
+    ruby-plain
     prefecture-name
     prefecture-kanji
     prefecture-hiragana
     prefecture-katakana
+    prefecture-code
+    region
+    location
     city-name
     city-kanji
     city-hiragana
     city-katakana
+    county-name
+    county-kanji
+    county-hiragana
+    county-katakana
+    municipality-name
+    municipality-kanji
+    municipality-hiragana
+    municipality-katakana
+    municipality-code
+    ward-name
+    ward-kanji
+    ward-hiragana
+    ward-katakana
     town-name
     town-kanji
     town-hiragana
@@ -170,6 +258,10 @@ func main() {
     name
     kanji
 
+  LAYOUT is a text with fields such as '{last}　{first} 様' and
+  '{last.ROMAJI} {first.romaji}'. Fields are last, first and maiden, and
+  scripts are kanji, hiragana, katakana, romaji and ROMAJI.
+
   Example:
     $ gimei -n 3 name:name name:hiragana address:name postal:name
     鈴木 真里緒, すずき まりお, 山口県新居浜市森川町, 060-0001
@@ -189,19 +281,23 @@ func main() {
 		os.Exit(0)
 	}
 
+	gimei.SetPopulationWeighted(weighted)
+
 	args := flag.Args()
 	if len(args) == 0 {
 		args = []string{"name:name"}
 	}
 
 	if jsonOutput {
-		var allRecords []map[string]string
+		w := bufio.NewWriter(os.Stdout)
+		enc := gimei.NewEncoder(w)
 		for i := 0; i < n; i++ {
 			var (
 				gimeiName, gimeiMale, gimeiFemale *gimei.Name       = nil, nil, nil
 				gimeiAddress                      *gimei.Address    = nil
 				gimeiPostalCode                   *gimei.PostalCode = nil
 				gimeiDog, gimeiCat                *gimei.Name       = nil, nil
+				gimeiNeutral                      *gimei.Name       = nil
 			)
 			record := make(map[string]string)
 			for _, arg := range args {
@@ -233,6 +329,12 @@ func main() {
 					}
 					result = doName(gimeiFemale, tokens[1])
 					fieldName = "female"
+				case "neutral":
+					if gimeiNeutral == nil {
+						gimeiNeutral = gimei.NewNeutralName()
+					}
+					result = doName(gimeiNeutral, tokens[1])
+					fieldName = "neutral"
 				case "address":
 					if gimeiAddress == nil {
 						gimeiAddress = gimei.NewAddress()
@@ -263,14 +365,19 @@ func main() {
 				}
 				record[fieldName] = result
 			}
-			allRecords = append(allRecords, record)
+			if err := enc.Encode(record); err != nil {
+				fmt.Fprintf(os.Stderr, "Error generating JSON: %v\n", err)
+				os.Exit(1)
+			}
+		}
+		err := enc.Close()
+		if err == nil {
+			err = w.Flush()
 		}
-		jsonData, err := json.Marshal(allRecords)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error generating JSON: %v\n", err)
 			os.Exit(1)
 		}
-		fmt.Println(string(jsonData))
 	} else {
 		for i := 0; i < n; i++ {
 			var (
@@ -278,6 +385,7 @@ func main() {
 				gimeiAddress                      *gimei.Address    = nil
 				gimeiPostalCode                   *gimei.PostalCode = nil
 				gimeiDog, gimeiCat                *gimei.Name       = nil, nil
+				gimeiNeutral                      *gimei.Name       = nil
 			)
 			for i, arg := range args {
 				tokens := strings.SplitN(arg, ":", 2)
@@ -304,6 +412,11 @@ func main() {
 						gimeiFemale = gimei.NewFemale()
 					}
 					result = doName(gimeiFemale, tokens[1])
+				case "neutral":
+					if gimeiNeutral == nil {
+						gimeiNeutral = gimei.NewNeutralName()
+					}
+					result = doName(gimeiNeutral, tokens[1])
 				case "address":
 					if gimeiAddress == nil {
 						gimeiAddress = gimei.NewAddress()
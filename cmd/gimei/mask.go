@@ -0,0 +1,230 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mattn/go-gimei"
+)
+
+// maskColumn is a column of CSV rewritten with the field of the entity.
+type maskColumn struct {
+	index int
+	kind  string // entity such as name or address
+	arg   string // field such as last-kanji
+}
+
+// maskFields are fields which doName, doAddress and doPostalCode know.
+var maskFields = map[string][]string{
+	"name": {
+		"name", "kanji", "hiragana", "katakana", "ruby", "ruby-katakana", "ruby-plain", "romaji",
+		"last-name", "last-kanji", "last-hiragana", "last-katakana", "last-romaji",
+		"first-name", "first-kanji", "first-hiragana", "first-katakana", "first-romaji",
+		"is-male", "is-female", "is-neutral", "sex", "sex-code",
+	},
+	"address": {
+		"name", "kanji", "hiragana", "katakana", "ruby", "ruby-katakana", "ruby-plain",
+		"prefecture-name", "prefecture-kanji", "prefecture-hiragana", "prefecture-katakana", "prefecture-code",
+		"region", "location",
+		"city-name", "city-kanji", "city-hiragana", "city-katakana",
+		"county-name", "county-kanji", "county-hiragana", "county-katakana",
+		"municipality-name", "municipality-kanji", "municipality-hiragana", "municipality-katakana", "municipality-code",
+		"ward-name", "ward-kanji", "ward-hiragana", "ward-katakana",
+		"town-name", "town-kanji", "town-hiragana", "town-katakana",
+	},
+	"postal": {"name", "kanji"},
+}
+
+// isMaskField return true if arg is a field of the entity. The silent default
+// of doName and others should not write the wrong data into a column.
+func isMaskField(kind, arg string) bool {
+	switch kind {
+	case "name", "male", "female", "neutral", "dog", "cat":
+		if strings.HasPrefix(arg, "format=") {
+			return true
+		}
+		kind = "name"
+	}
+	for _, f := range maskFields[kind] {
+		if f == arg {
+			return true
+		}
+	}
+	return false
+}
+
+func parseMaskColumns(spec string, header []string) ([]maskColumn, error) {
+	var columns []maskColumn
+	for _, s := range strings.Split(spec, ",") {
+		tokens := strings.SplitN(s, "=", 2)
+		if len(tokens) != 2 {
+			return nil, fmt.Errorf("invalid column: %q", s)
+		}
+		index := -1
+		for i, h := range header {
+			if h == tokens[0] {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, fmt.Errorf("column not found: %q", tokens[0])
+		}
+		entity := strings.SplitN(tokens[1], ":", 2)
+		if len(entity) == 1 {
+			entity = append(entity, "name")
+		}
+		switch entity[0] {
+		case "name", "male", "female", "neutral", "address", "postal", "dog", "cat":
+		default:
+			return nil, fmt.Errorf("unknown entity: %q", entity[0])
+		}
+		if !isMaskField(entity[0], entity[1]) {
+			return nil, fmt.Errorf("unknown field of %s: %q", entity[0], entity[1])
+		}
+		columns = append(columns, maskColumn{index: index, kind: entity[0], arg: entity[1]})
+	}
+	return columns, nil
+}
+
+// maskValue return the field of the entity. entities store entities generated
+// for the row so that columns of the same entity share one.
+func maskValue(entities map[string]interface{}, kind, arg string) string {
+	e, ok := entities[kind]
+	if !ok {
+		switch kind {
+		case "name":
+			e = gimei.NewName()
+		case "male":
+			e = gimei.NewMale()
+		case "female":
+			e = gimei.NewFemale()
+		case "neutral":
+			e = gimei.NewNeutralName()
+		case "address":
+			e = gimei.NewAddress()
+		case "postal":
+			e = gimei.NewPostalCode()
+		case "dog":
+			e = gimei.NewDog()
+		case "cat":
+			e = gimei.NewCat()
+		}
+		entities[kind] = e
+	}
+	switch e := e.(type) {
+	case *gimei.Name:
+		return doName(e, arg)
+	case *gimei.Address:
+		return doAddress(e, arg)
+	case *gimei.PostalCode:
+		return doPostalCode(e, arg)
+	}
+	return ""
+}
+
+// mask rewrite the columns of CSV read from r row by row.
+func mask(w io.Writer, r io.Reader, spec string) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+	cw := csv.NewWriter(w)
+
+	header, err := cr.Read()
+	if err != nil {
+		return fmt.Errorf("cannot read header: %w", err)
+	}
+	columns, err := parseMaskColumns(spec, header)
+	if err != nil {
+		return err
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		entities := map[string]interface{}{}
+		for _, c := range columns {
+			if c.index < len(record) {
+				record[c.index] = maskValue(entities, c.kind, c.arg)
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func runMask(args []string) int {
+	fs := flag.NewFlagSet("mask", flag.ExitOnError)
+	var in, out, columns string
+	var weighted bool
+	fs.StringVar(&in, "in", "-", "input CSV file")
+	fs.StringVar(&out, "out", "-", "output CSV file")
+	fs.StringVar(&columns, "columns", "", "columns to rewrite")
+	fs.BoolVar(&weighted, "weighted", false, "pick address weighted by population")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, `Usage: gimei mask [OPTIONS]
+
+  -in file
+        input CSV file with header (default: stdin)
+  -out file
+        output CSV file (default: stdout)
+  -columns COLUMN=ARG[,COLUMN=ARG...]
+        columns to rewrite with ARG of gimei such as name:last-kanji
+  -weighted
+        pick address weighted by population
+
+  Columns referring to the same entity share one generated person or
+  address in a row.
+
+  Example:
+    $ gimei mask -in users.csv -columns last_name=name:last-kanji,last_kana=name:last-katakana,addr=address:kanji
+`)
+	}
+	fs.Parse(args)
+	if columns == "" {
+		fs.Usage()
+		return 2
+	}
+
+	gimei.SetPopulationWeighted(weighted)
+
+	r := io.Reader(os.Stdin)
+	if in != "-" {
+		f, err := os.Open(in)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer f.Close()
+		r = f
+	}
+	w := io.Writer(os.Stdout)
+	if out != "-" {
+		f, err := os.Create(out)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := mask(w, r, columns); err != nil {
+		fmt.Fprintf(os.Stderr, "gimei mask: %v\n", err)
+		return 1
+	}
+	return 0
+}
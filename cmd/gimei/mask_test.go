@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		columns string
+		check   func(t *testing.T, header []string, rows [][]string)
+		wantErr string
+	}{
+		{
+			name:    "header and other columns pass through",
+			in:      "id,last_name,note\n1,山田,\"a,b\"\n2,佐藤,x\n",
+			columns: "last_name=name:last-kanji",
+			check: func(t *testing.T, header []string, rows [][]string) {
+				if strings.Join(header, ",") != "id,last_name,note" {
+					t.Fatalf("header should pass through: %v", header)
+				}
+				if len(rows) != 2 || rows[0][0] != "1" || rows[0][2] != "a,b" || rows[1][2] != "x" {
+					t.Fatalf("other columns should pass through: %v", rows)
+				}
+				if rows[0][1] == "山田" && rows[1][1] == "佐藤" {
+					t.Fatalf("column should be masked: %v", rows)
+				}
+			},
+		},
+		{
+			name:    "same entity share one person",
+			in:      "full,last,kana,addr,pref\nx,x,x,x,x\nx,x,x,x,x\nx,x,x,x,x\n",
+			columns: "full=name,last=name:last-kanji,kana=name:last-katakana,addr=address,pref=address:prefecture-kanji",
+			check: func(t *testing.T, header []string, rows [][]string) {
+				for _, row := range rows {
+					n := gimei.FindNameByKanji(row[0])
+					if n == nil || !strings.HasPrefix(row[0], row[1]+" ") || n.Last.Kanji() != row[1] {
+						t.Fatalf("name columns should share one person: %v", row)
+					}
+					if !strings.HasPrefix(row[3], row[4]) {
+						t.Fatalf("address columns should share one address: %v", row)
+					}
+				}
+			},
+		},
+		{
+			name:    "short rows",
+			in:      "id,name\n1\n2,x\n",
+			columns: "name=name",
+			check: func(t *testing.T, header []string, rows [][]string) {
+				if len(rows[0]) != 1 || rows[0][0] != "1" {
+					t.Fatalf("short row should be kept as it is: %v", rows[0])
+				}
+				if len(rows[1]) != 2 || rows[1][1] == "x" {
+					t.Fatalf("row should be masked: %v", rows[1])
+				}
+			},
+		},
+		{
+			name:    "unknown column",
+			in:      "id,name\n1,x\n",
+			columns: "foo=name",
+			wantErr: "column not found",
+		},
+		{
+			name:    "unknown entity",
+			in:      "id,name\n1,x\n",
+			columns: "name=foo",
+			wantErr: "unknown entity",
+		},
+		{
+			name:    "unknown field",
+			in:      "id,last_kana\n1,x\n",
+			columns: "last_kana=name:last-katakan",
+			wantErr: "unknown field",
+		},
+		{
+			name:    "unknown field of postal code",
+			in:      "id,zip\n1,x\n",
+			columns: "zip=postal:hiragana",
+			wantErr: "unknown field",
+		},
+		{
+			name:    "format of name",
+			in:      "id,name\n1,x\n",
+			columns: "name=female:format={last}様",
+			check: func(t *testing.T, header []string, rows [][]string) {
+				if !strings.HasSuffix(rows[0][1], "様") {
+					t.Fatalf("name should be formatted: %v", rows[0])
+				}
+			},
+		},
+		{
+			name:    "invalid column",
+			in:      "id,name\n1,x\n",
+			columns: "name",
+			wantErr: "invalid column",
+		},
+		{
+			name:    "empty input",
+			in:      "",
+			columns: "name=name",
+			wantErr: "cannot read header",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var out bytes.Buffer
+			err := mask(&out, strings.NewReader(test.in), test.columns)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("want error %q but %v", test.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := csv.NewReader(&out)
+			r.FieldsPerRecord = -1
+			records, err := r.ReadAll()
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.check(t, records[0], records[1:])
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package gimei
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+var errEncoderClosed = errors.New("gimei: Encoder is already closed")
+
+// Encoder write values to io.Writer as JSON array one by one, so that many
+// records can be written without building slice of them. Call Close to finish
+// the array. Encode and Close return error after Close.
+type Encoder struct {
+	w      io.Writer
+	n      int
+	err    error
+	closed bool
+}
+
+// NewEncoder return new instance of Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode write v as an element of the array.
+func (e *Encoder) Encode(v interface{}) error {
+	if e.closed {
+		return errEncoderClosed
+	}
+	if e.err != nil {
+		return e.err
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	sep := ","
+	if e.n == 0 {
+		sep = "["
+	}
+	if _, e.err = io.WriteString(e.w, sep); e.err != nil {
+		return e.err
+	}
+	_, e.err = e.w.Write(b)
+	e.n++
+	return e.err
+}
+
+// Close finish the array. It does not close the writer.
+func (e *Encoder) Close() error {
+	if e.closed {
+		return errEncoderClosed
+	}
+	e.closed = true
+	if e.err != nil {
+		return e.err
+	}
+	end := "]\n"
+	if e.n == 0 {
+		end = "[]\n"
+	}
+	_, e.err = io.WriteString(e.w, end)
+	return e.err
+}
@@ -0,0 +1,50 @@
+package gimei_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	e := gimei.NewEncoder(&buf)
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "[]\n" {
+		t.Fatalf("want empty array but %q", buf.String())
+	}
+
+	buf.Reset()
+	e = gimei.NewEncoder(&buf)
+	want := gimei.NewNames(3)
+	for _, n := range want {
+		if err := e.Encode(n); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Encode(want[0]); err == nil {
+		t.Fatal("Encode after Close should be error")
+	}
+	if err := e.Close(); err == nil {
+		t.Fatal("Close after Close should be error")
+	}
+	var got []gimei.Name
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("%v: %q", err, buf.String())
+	}
+	if len(got) != len(want) {
+		t.Fatalf("want %d names but %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i].Kanji() != want[i].Kanji() {
+			t.Errorf("want %q but %q", want[i], &got[i])
+		}
+	}
+}
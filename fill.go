@@ -0,0 +1,244 @@
+package gimei
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Fill populate fields of struct pointed by v that are tagged like
+// `gimei:"name.last.katakana"`. The tag is entity and field separated by dots.
+// Entities are name, male, female, neutral, dog, cat, address and postal.
+// Fields are same as arguments of cmd/gimei, so `gimei:"address:prefecture-code"`
+// is also accepted. Fields tagged with the same entity share one generated
+// Name or Address. Fields of nested structs are also populated.
+//
+// String fields are set to the string of the value, and fields of type Name,
+// Address, PostalCode, Item, Sex or bool are set to the value as it is.
+func Fill(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("gimei: Fill requires non-nil pointer to struct")
+	}
+	return fillStruct(rv.Elem(), map[string]interface{}{})
+}
+
+func fillStruct(rv reflect.Value, entities map[string]interface{}) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+		fv := rv.Field(i)
+		tag, ok := sf.Tag.Lookup("gimei")
+		if !ok {
+			if fv.Kind() == reflect.Ptr && !fv.IsNil() {
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				if err := fillStruct(fv, entities); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if tag == "-" {
+			continue
+		}
+		value, err := resolveTag(tag, entities)
+		if err != nil {
+			return fmt.Errorf("gimei: field %s: %w", sf.Name, err)
+		}
+		if !setField(fv, value) {
+			return fmt.Errorf("gimei: field %s: cannot set %T to %s", sf.Name, value, fv.Type())
+		}
+	}
+	return nil
+}
+
+// resolveTag return value for the tag. entities store generated entities by
+// the name.
+func resolveTag(tag string, entities map[string]interface{}) (interface{}, error) {
+	entity, field := tag, ""
+	if i := strings.IndexAny(tag, ".:"); i >= 0 {
+		entity, field = tag[:i], tag[i+1:]
+	}
+	field = strings.Map(func(c rune) rune {
+		if c == '-' {
+			return '.'
+		}
+		return c
+	}, field)
+
+	e, ok := entities[entity]
+	if !ok {
+		switch entity {
+		case "name":
+			e = NewName()
+		case "male":
+			e = NewMale()
+		case "female":
+			e = NewFemale()
+		case "neutral":
+			e = NewNeutralName()
+		case "dog":
+			e = NewDog()
+		case "cat":
+			e = NewCat()
+		case "address":
+			e = NewAddress()
+		case "postal":
+			e = NewPostalCode()
+		default:
+			return nil, fmt.Errorf("unknown entity %q", entity)
+		}
+		entities[entity] = e
+	}
+
+	var value interface{}
+	switch e := e.(type) {
+	case *Name:
+		value, ok = nameField(e, field)
+	case *Address:
+		value, ok = addressField(e, field)
+	case *PostalCode:
+		value, ok = e, field == "" || field == "kanji" || field == "code" || field == "name"
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q of %s", field, entity)
+	}
+	return value, nil
+}
+
+func nameField(n *Name, field string) (interface{}, bool) {
+	switch field {
+	case "", "name":
+		return n, true
+	case "kanji":
+		return n.Kanji(), true
+	case "hiragana":
+		return n.Hiragana(), true
+	case "katakana":
+		return n.Katakana(), true
+	case "romaji":
+		return n.Romaji(), true
+	case "sex":
+		return n.Sex, true
+	case "sex.code":
+		return n.Sex.ISO5218(), true
+	case "is.male":
+		return n.IsMale(), true
+	case "is.female":
+		return n.IsFemale(), true
+	case "is.neutral":
+		return n.IsNeutral(), true
+	}
+	part, script := splitField(field)
+	switch part {
+	case "last":
+		return itemField(n.Last, script)
+	case "first":
+		return itemField(n.First, script)
+	case "maiden":
+		return itemField(n.MaidenName(), script)
+	}
+	return nil, false
+}
+
+func addressField(a *Address, field string) (interface{}, bool) {
+	switch field {
+	case "", "name":
+		return a, true
+	case "kanji":
+		return a.Kanji(), true
+	case "hiragana":
+		return a.Hiragana(), true
+	case "katakana":
+		return a.Katakana(), true
+	case "prefecture.code":
+		return a.PrefectureCode(), true
+	case "municipality.code":
+		return a.MunicipalityCode(), true
+	case "region":
+		return a.Region(), true
+	case "location":
+		return a.Location(), true
+	}
+	part, script := splitField(field)
+	switch part {
+	case "prefecture":
+		return itemField(a.Prefecture, script)
+	case "city":
+		return itemField(a.City, script)
+	case "county":
+		return itemField(a.County(), script)
+	case "municipality":
+		return itemField(a.Municipality(), script)
+	case "ward":
+		return itemField(a.Ward(), script)
+	case "town":
+		return itemField(a.Town, script)
+	}
+	return nil, false
+}
+
+func splitField(field string) (part, script string) {
+	if i := strings.IndexByte(field, '.'); i >= 0 {
+		return field[:i], field[i+1:]
+	}
+	return field, ""
+}
+
+func itemField(i Item, script string) (interface{}, bool) {
+	switch script {
+	case "", "name":
+		return i, true
+	case "kanji":
+		return i.Kanji(), true
+	case "hiragana":
+		return i.Hiragana(), true
+	case "katakana":
+		return i.Katakana(), true
+	case "romaji":
+		return i.Romaji(), true
+	}
+	return nil, false
+}
+
+// setField set value to field. It return false if the value can not be set.
+func setField(fv reflect.Value, value interface{}) bool {
+	rv := reflect.ValueOf(value)
+	if rv.Type().AssignableTo(fv.Type()) {
+		fv.Set(rv)
+		return true
+	}
+	if rv.Kind() == reflect.Ptr && rv.Elem().Type().AssignableTo(fv.Type()) {
+		fv.Set(rv.Elem())
+		return true
+	}
+	switch fv.Kind() {
+	case reflect.String:
+		if rv.Kind() == reflect.String {
+			fv.SetString(rv.String())
+			return true
+		}
+		if s, ok := value.(fmt.Stringer); ok {
+			fv.SetString(s.String())
+			return true
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if rv.Kind() == reflect.Int {
+			fv.SetInt(rv.Int())
+			return true
+		}
+	case reflect.Ptr:
+		p := reflect.New(fv.Type().Elem())
+		if setField(p.Elem(), value) {
+			fv.Set(p)
+			return true
+		}
+	}
+	return false
+}
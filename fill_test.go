@@ -0,0 +1,91 @@
+package gimei_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+type fillProfile struct {
+	Bio        string `gimei:"-"`
+	Phone      string
+	Postal     string     `gimei:"postal"`
+	Mother     string     `gimei:"female.kanji"`
+	MotherKana string     `gimei:"female.katakana"`
+	MotherSex  int        `gimei:"female.sex.code"`
+	MotherName gimei.Name `gimei:"female"`
+}
+
+type fillUser struct {
+	LastName   string         `gimei:"name.last"`
+	LastKana   string         `gimei:"name.last.katakana"`
+	FirstName  string         `gimei:"name:first-kanji"`
+	FullName   string         `gimei:"name"`
+	Romaji     *string        `gimei:"name.romaji"`
+	IsMale     bool           `gimei:"name.is-male"`
+	Sex        gimei.Sex      `gimei:"name.sex"`
+	Name       gimei.Name     `gimei:"name"`
+	Prefecture string         `gimei:"address.prefecture"`
+	PrefCode   string         `gimei:"address:prefecture-code"`
+	City       gimei.Item     `gimei:"address.city"`
+	Address    *gimei.Address `gimei:"address"`
+	Profile    fillProfile
+	unexported string `gimei:"name"`
+}
+
+func TestFill(t *testing.T) {
+	var u fillUser
+	u.Profile.Bio = "keep"
+	if err := gimei.Fill(&u); err != nil {
+		t.Fatal(err)
+	}
+	name := &u.Name
+	if u.LastName != name.Last.Kanji() || u.LastKana != name.Last.Katakana() || u.FirstName != name.First.Kanji() {
+		t.Fatalf("fields should share one name: %#v", u)
+	}
+	if u.Romaji == nil || *u.Romaji != name.Romaji() || u.IsMale != name.IsMale() || u.Sex != name.Sex || u.Name.Kanji() != u.FullName {
+		t.Fatalf("fields should share one name: %#v", u)
+	}
+	if u.Address == nil || u.Address.Prefecture.Kanji() != u.Prefecture || u.Address.City.Kanji() != u.City.Kanji() {
+		t.Fatalf("fields should share one address: %#v", u)
+	}
+	if u.PrefCode != u.Address.PrefectureCode() {
+		t.Fatalf("want %q but %q", u.Address.PrefectureCode(), u.PrefCode)
+	}
+	if u.Profile.Bio != "keep" || u.Profile.Phone != "" || u.unexported != "" {
+		t.Fatalf("untagged fields should not be changed: %#v", u)
+	}
+	if len(u.Profile.Postal) != 8 || !strings.Contains(u.Profile.Postal, "-") {
+		t.Fatalf("invalid postal code: %q", u.Profile.Postal)
+	}
+	mother := &u.Profile.MotherName
+	if mother.Sex != gimei.Female || u.Profile.MotherSex != 2 {
+		t.Fatalf("mother should be female: %v (%d)", mother.Sex, u.Profile.MotherSex)
+	}
+	if u.Profile.Mother != mother.Kanji() || u.Profile.MotherKana != mother.Katakana() {
+		t.Fatalf("fields should share one mother: %#v", u.Profile)
+	}
+}
+
+func TestFillError(t *testing.T) {
+	tests := []interface{}{
+		nil,
+		fillUser{},
+		&[]string{},
+		&struct {
+			X string `gimei:"unknown"`
+		}{},
+		&struct {
+			X string `gimei:"name.unknown"`
+		}{},
+		&struct {
+			X float64 `gimei:"name"`
+		}{},
+	}
+	for _, v := range tests {
+		if err := gimei.Fill(v); err == nil {
+			t.Fatalf("Fill(%#v) should be error", v)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package gimei
+
+import (
+	"strings"
+)
+
+// IsCity return true if the address is in 市.
+func (a *Address) IsCity() bool {
+	return strings.HasSuffix(a.Municipality().Kanji(), "市")
+}
+
+// IsSpecialWard return true if the address is in 23 special wards of Tokyo.
+func (a *Address) IsSpecialWard() bool {
+	return a.Prefecture.Kanji() == "東京都" && strings.HasSuffix(a.City.Kanji(), "区")
+}
+
+// IsVillage return true if the address is in 村.
+func (a *Address) IsVillage() bool {
+	return strings.HasSuffix(a.Municipality().Kanji(), "村")
+}
+
+// IsIsland return true if the city of address is made up of remote islands
+// (離島).
+func (a *Address) IsIsland() bool {
+	onceAddress.Do(loadAddresses)
+	return islandCities[a.City.Kanji()]
+}
+
+// AddressFilter report whether the address should be generated. Town of the
+// address is not decided yet when AddressFilter is called. Methods of Address
+// can be used as AddressFilter like below.
+//
+//	gimei.NewAddressWith((*gimei.Address).IsCity, gimei.Not((*gimei.Address).IsIsland))
+type AddressFilter func(a *Address) bool
+
+// InPrefecture return AddressFilter which accepts address in the prefectures.
+func InPrefecture(prefectures ...string) AddressFilter {
+	return func(a *Address) bool {
+		for _, prefecture := range prefectures {
+			if a.Prefecture.Kanji() == prefecture {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// InRegion return AddressFilter which accepts address in the regions.
+func InRegion(regions ...Region) AddressFilter {
+	return func(a *Address) bool {
+		for _, region := range regions {
+			if a.Region() == region {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not return AddressFilter which accepts address that filter rejects.
+func Not(filter AddressFilter) AddressFilter {
+	return func(a *Address) bool {
+		return !filter(a)
+	}
+}
+
+// NewAddressWith return new instance of address that all filters accept.
+// Unlike NewAddress, city of the address is in the prefecture. It return nil
+// if no address is accepted. When population weighted mode is enabled, the
+// address is picked by the population.
+func NewAddressWith(filters ...AddressFilter) *Address {
+	candidates := filterCities(filters)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	return global().addressOf(candidates)
+}
+
+// NewAddressWith return new instance of address that all filters accept. See
+// NewAddressWith.
+func (g *Generator) NewAddressWith(filters ...AddressFilter) *Address {
+	candidates := filterCities(filters)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().addressOf(candidates)
+}
+
+// filterCities return indexes of cities that all filters accept. It is called
+// without the lock because filters may be slow.
+func filterCities(filters []AddressFilter) []int {
+	onceAddress.Do(loadAddresses)
+
+	var candidates []int
+next:
+	for i, city := range addresses.Addresses.City {
+		a := &Address{Prefecture: cityPrefecture[i], City: city}
+		for _, filter := range filters {
+			if !filter(a) {
+				continue next
+			}
+		}
+		candidates = append(candidates, i)
+	}
+	return candidates
+}
+
+// addressOf return new instance of address in one of the cities. It return
+// nil if candidates is empty.
+func (p picker) addressOf(candidates []int) *Address {
+	if len(candidates) == 0 {
+		return nil
+	}
+	var i int
+	if p.populationWeighted {
+		onceWeight.Do(loadWeights)
+		weights := make([]int64, len(candidates))
+		for k, c := range candidates {
+			weights[k] = cityWeights[c]
+		}
+		i = candidates[p.weighted(weights)]
+	} else {
+		i = candidates[p.rand.Intn(len(candidates))]
+	}
+	return &Address{
+		Prefecture: cityPrefecture[i],
+		City:       addresses.Addresses.City[i],
+		Town:       p.town(),
+	}
+}
+
+// NewAddressIn return new instance of address in the prefecture. It return nil
+// if prefecture is unknown.
+func NewAddressIn(prefecture string) *Address {
+	return NewAddressWith(InPrefecture(prefecture))
+}
+
+// NewAddressInRegion return new instance of address in the region.
+func NewAddressInRegion(region Region) *Address {
+	return NewAddressWith(InRegion(region))
+}
+
+// NewAddressIn return new instance of address in the prefecture. See
+// NewAddressIn.
+func (g *Generator) NewAddressIn(prefecture string) *Address {
+	return g.NewAddressWith(InPrefecture(prefecture))
+}
+
+// NewAddressInRegion return new instance of address in the region.
+func (g *Generator) NewAddressInRegion(region Region) *Address {
+	return g.NewAddressWith(InRegion(region))
+}
@@ -0,0 +1,55 @@
+package gimei_test
+
+import (
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestNewAddressIn(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		addr := gimei.NewAddressIn("大阪府")
+		if addr.Prefecture.Kanji() != "大阪府" {
+			t.Fatalf("NewAddressIn(大阪府) returns %s", addr)
+		}
+		if addr.Region() != gimei.Kinki {
+			t.Fatalf("Region() of %s == %v, want %v", addr, addr.Region(), gimei.Kinki)
+		}
+	}
+	if addr := gimei.NewAddressIn("大阪県"); addr != nil {
+		t.Errorf("NewAddressIn(大阪県) should return nil: %s", addr)
+	}
+}
+
+func TestNewAddressInRegion(t *testing.T) {
+	kanto := map[string]bool{
+		"茨城県": true, "栃木県": true, "群馬県": true, "埼玉県": true,
+		"千葉県": true, "東京都": true, "神奈川県": true,
+	}
+	for i := 0; i < 100; i++ {
+		addr := gimei.NewAddressInRegion(gimei.Kanto)
+		if !kanto[addr.Prefecture.Kanji()] {
+			t.Fatalf("NewAddressInRegion(Kanto) returns %s", addr)
+		}
+	}
+}
+
+func TestNewAddressWith(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		addr := gimei.NewAddressWith((*gimei.Address).IsSpecialWard)
+		if addr.Prefecture.Kanji() != "東京都" || addr.County() != nil || addr.Ward() != nil {
+			t.Fatalf("NewAddressWith(IsSpecialWard) returns %s", addr)
+		}
+		addr = gimei.NewAddressWith(gimei.InRegion(gimei.Kyushu), gimei.Not((*gimei.Address).IsCity))
+		if addr.IsCity() || addr.Region() != gimei.Kyushu {
+			t.Fatalf("NewAddressWith(InRegion(Kyushu), Not(IsCity)) returns %s", addr)
+		}
+		addr = gimei.NewAddressWith(gimei.InPrefecture("東京都"), gimei.Not((*gimei.Address).IsIsland))
+		if addr.IsIsland() {
+			t.Fatalf("NewAddressWith(Not(IsIsland)) returns %s", addr)
+		}
+	}
+	if addr := gimei.FindAddressByKanji("東京都小笠原村稲木町"); addr == nil || !addr.IsIsland() {
+		t.Errorf("IsIsland() of 小笠原村 should return true")
+	}
+}
@@ -0,0 +1,333 @@
+package gimei
+
+import (
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	foreignNames    map[Origin]*foreignName
+	onceForeignName sync.Once
+)
+
+// Origin is origin of name of foreign resident.
+type Origin int
+
+// list of origin
+const (
+	Western Origin = iota + 1 // 欧米
+	Chinese                   // 中国
+	Korean                    // 韓国・朝鮮
+)
+
+// String implement Stringer.
+func (o Origin) String() string {
+	switch o {
+	case Western:
+		return "欧米"
+	case Chinese:
+		return "中国"
+	case Korean:
+		return "韓国・朝鮮"
+	}
+	return "？"
+}
+
+// originKeys map keys in foreignnames.yml to Origin.
+var originKeys = map[string]Origin{
+	"western": Western,
+	"chinese": Chinese,
+	"korean":  Korean,
+}
+
+// aliasRates store 1/N of people who have 通称名 by origin.
+var aliasRates = map[Origin]int{
+	Western: 20,
+	Chinese: 5,
+	Korean:  2,
+}
+
+// foreignNameData store data sturecture just same as foreignnames.yml.
+type foreignNameData struct {
+	ForeignNames map[string]struct {
+		Nationality []string   `yaml:"nationality"`
+		Last        [][]string `yaml:"last"`
+		Male        [][]string `yaml:"male"`
+		Female      [][]string `yaml:"female"`
+	} `yaml:"foreign_names"`
+}
+
+// foreignName store names of the origin. native is reading in the language of
+// the origin, and it is nil for western names.
+type foreignName struct {
+	nationality  []string
+	last         []Item
+	male         []Item
+	female       []Item
+	nativeLast   []Item
+	nativeMale   []Item
+	nativeFemale []Item
+	aliasLast    map[string][]Item // Japanese last names used as 通称名
+}
+
+func loadForeignNames() {
+	onceName.Do(loadNames)
+	var data foreignNameData
+	if b, err := assets.ReadFile("data/foreignnames.yml"); err == nil {
+		if err = yaml.Unmarshal(b, &data); err == nil {
+			foreignNames = make(map[Origin]*foreignName, len(data.ForeignNames))
+			for key, d := range data.ForeignNames {
+				origin, ok := originKeys[key]
+				if !ok {
+					panic("unknown origin in foreign names data: " + key)
+				}
+				f := &foreignName{nationality: d.Nationality}
+				f.last, f.nativeLast = foreignItems(d.Last)
+				f.male, f.nativeMale = foreignItems(d.Male)
+				f.female, f.nativeFemale = foreignItems(d.Female)
+				f.aliasLast = make(map[string][]Item)
+				for _, item := range f.last {
+					for _, last := range names.LastName {
+						if strings.HasPrefix(last.Kanji(), item.Kanji()) {
+							f.aliasLast[item.Kanji()] = append(f.aliasLast[item.Kanji()], last)
+						}
+					}
+				}
+				foreignNames[origin] = f
+			}
+			return
+		}
+	}
+	panic("failed to load foreign names data")
+}
+
+// foreignItems return Items of rows in foreignnames.yml. Rows of western names
+// are [katakana, romaji], and the others are [kanji, hiragana, romaji, native
+// katakana, native romaji].
+func foreignItems(rows [][]string) (items []Item, native []Item) {
+	for _, row := range rows {
+		switch len(row) {
+		case 2:
+			items = append(items, Item{row[0], toHiragana(row[0]), row[0], row[1]})
+		case 5:
+			items = append(items, Item{row[0], row[1], toKatakana(row[1]), row[2]})
+			native = append(native, Item{row[0], toHiragana(row[3]), row[3], row[4]})
+		default:
+			panic("invalid row in foreign names data: " + strings.Join(row, ","))
+		}
+	}
+	return items, native
+}
+
+func toHiragana(s string) string {
+	return strings.Map(func(c rune) rune {
+		if c >= 'ァ' && c <= 'ヶ' {
+			return c - 0x60
+		}
+		return c
+	}, s)
+}
+
+func toKatakana(s string) string {
+	return strings.Map(func(c rune) rune {
+		if c >= 'ぁ' && c <= 'ゖ' {
+			return c + 0x60
+		}
+		return c
+	}, s)
+}
+
+// ForeignName store name of foreign resident in Japan. Western names are
+// registered in katakana, so Kanji of them is katakana. Chinese and Korean
+// names are registered in kanji, and Hiragana/Katakana of them are Japanese
+// readings.
+type ForeignName struct {
+	Name
+	Middle      Item   // middle name. It is nil if none.
+	Native      *Name  // reading in native language such as キム ミンジュン. It is nil for western names.
+	Alias       *Name  // 通称名. It is nil if not registered.
+	Origin      Origin // origin of the name
+	Nationality string // ISO 3166-1 alpha-2 code such as "US"
+}
+
+// String implement Stringer.
+func (f *ForeignName) String() string {
+	return f.Kanji()
+}
+
+// Kanji return string of ForeignName as registered such as
+// "スミス ジョン マイケル" or "金 民俊".
+func (f *ForeignName) Kanji() string {
+	return joinNonEmpty(f.Last.Kanji(), f.First.Kanji(), f.Middle.Kanji())
+}
+
+// Hiragana return string of ForeignName as hiragana.
+func (f *ForeignName) Hiragana() string {
+	return joinNonEmpty(f.Last.Hiragana(), f.First.Hiragana(), f.Middle.Hiragana())
+}
+
+// Katakana return string of ForeignName as katakana.
+func (f *ForeignName) Katakana() string {
+	return joinNonEmpty(f.Last.Katakana(), f.First.Katakana(), f.Middle.Katakana())
+}
+
+// Romaji return string of ForeignName as romaji in order of given name, middle
+// name and last name. Native reading is used for Chinese and Korean names.
+func (f *ForeignName) Romaji() string {
+	if f.Native != nil {
+		return f.Native.Romaji()
+	}
+	return joinNonEmpty(f.First.Romaji(), f.Middle.Romaji(), f.Last.Romaji())
+}
+
+// Alphabet return string of ForeignName in capital letters as written on
+// 在留カード such as "SMITH JOHN MICHAEL".
+func (f *ForeignName) Alphabet() string {
+	n := &f.Name
+	if f.Native != nil {
+		n = f.Native
+	}
+	return strings.ToUpper(joinNonEmpty(n.Last.Romaji(), n.First.Romaji(), f.Middle.Romaji()))
+}
+
+func joinNonEmpty(s ...string) string {
+	var list []string
+	for _, v := range s {
+		if v != "" {
+			list = append(list, v)
+		}
+	}
+	return strings.Join(list, " ")
+}
+
+// NewForeignName return new instance of foreign resident whose origin is
+// picked randomly.
+func NewForeignName() *ForeignName {
+	mu.Lock()
+	defer mu.Unlock()
+
+	p := global()
+	return p.foreignName(Origin(1 + p.rand.Intn(int(Korean))))
+}
+
+// NewForeignNameOf return new instance of foreign resident of the origin.
+func NewForeignNameOf(origin Origin) *ForeignName {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return global().foreignName(origin)
+}
+
+// NewForeignName return new instance of foreign resident whose origin is
+// picked randomly.
+func (g *Generator) NewForeignName() *ForeignName {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	p := g.picker()
+	return p.foreignName(Origin(1 + p.rand.Intn(int(Korean))))
+}
+
+// NewForeignNameOf return new instance of foreign resident of the origin.
+func (g *Generator) NewForeignNameOf(origin Origin) *ForeignName {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().foreignName(origin)
+}
+
+// foreignName return foreign resident of the origin. Some of western names
+// have a middle name, and some of the others have 通称名.
+func (p picker) foreignName(origin Origin) *ForeignName {
+	onceForeignName.Do(loadForeignNames)
+	d, ok := foreignNames[origin]
+	if !ok {
+		return nil
+	}
+	f := &ForeignName{
+		Origin:      origin,
+		Nationality: d.nationality[p.rand.Intn(len(d.nationality))],
+	}
+	f.Sex = Sex(1 + p.rand.Intn(2))
+	first, nativeFirst := d.male, d.nativeMale
+	if f.Sex == Female {
+		first, nativeFirst = d.female, d.nativeFemale
+	}
+
+	i, j := p.rand.Intn(len(d.last)), p.rand.Intn(len(first))
+	f.Last, f.First = d.last[i], first[j]
+	if d.nativeLast != nil {
+		f.Native = &Name{First: nativeFirst[j], Last: d.nativeLast[i], Sex: f.Sex}
+	}
+	if origin == Western && p.rand.Intn(3) == 0 {
+		if k := p.rand.Intn(len(first)); k != j {
+			f.Middle = first[k]
+		}
+	}
+
+	if p.rand.Intn(aliasRates[origin]) == 0 {
+		f.Alias = &Name{Sex: f.Sex}
+		if list := d.aliasLast[f.Last.Kanji()]; len(list) > 0 {
+			f.Alias.Last = list[p.rand.Intn(len(list))]
+		} else {
+			f.Alias.Last = names.LastName[p.rand.Intn(len(names.LastName))]
+		}
+		if f.Sex == Male {
+			f.Alias.First = names.FirstName.Male[p.rand.Intn(len(names.FirstName.Male))]
+		} else {
+			f.Alias.First = names.FirstName.Female[p.rand.Intn(len(names.FirstName.Female))]
+		}
+	}
+	return f
+}
+
+// findForeignNameByKanji find ForeignName by kanji such as
+// "スミス ジョン マイケル".
+func findForeignNameByKanji(kanji string) *ForeignName {
+	onceForeignName.Do(loadForeignNames)
+	token := strings.Split(kanji, " ")
+	if len(token) != 2 && len(token) != 3 {
+		return nil
+	}
+	for origin := Western; origin <= Korean; origin++ {
+		d := foreignNames[origin]
+		i := indexOfKanji(d.last, token[0])
+		if i < 0 {
+			continue
+		}
+		for _, sex := range []Sex{Male, Female} {
+			first, nativeFirst := d.male, d.nativeMale
+			if sex == Female {
+				first, nativeFirst = d.female, d.nativeFemale
+			}
+			j := indexOfKanji(first, token[1])
+			if j < 0 {
+				continue
+			}
+			f := &ForeignName{Name: Name{First: first[j], Last: d.last[i], Sex: sex}, Origin: origin}
+			if len(token) == 3 {
+				k := indexOfKanji(first, token[2])
+				if k < 0 || origin != Western {
+					continue
+				}
+				f.Middle = first[k]
+			}
+			if d.nativeLast != nil {
+				f.Native = &Name{First: nativeFirst[j], Last: d.nativeLast[i], Sex: sex}
+			}
+			return f
+		}
+	}
+	return nil
+}
+
+func indexOfKanji(items []Item, kanji string) int {
+	for i, item := range items {
+		if item.Kanji() == kanji {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,57 @@
+package gimei_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestNewForeignName(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		f := gimei.NewForeignName()
+		if f.Kanji() == "" || f.Katakana() == "" || f.Hiragana() == "" || f.Romaji() == "" {
+			t.Fatalf("empty name: %#v", f)
+		}
+		if f.Alphabet() != strings.ToUpper(f.Alphabet()) {
+			t.Fatalf("alphabet should be capital letters: %q", f.Alphabet())
+		}
+		if f.Nationality == "" {
+			t.Fatalf("nationality should not be empty: %s", f)
+		}
+		if f.Alias != nil && gimei.FindNameByKanji(f.Alias.Kanji()) == nil {
+			t.Fatalf("alias should be Japanese name: %s", f.Alias)
+		}
+	}
+}
+
+func TestNewForeignNameOf(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		f := gimei.NewForeignNameOf(gimei.Western)
+		if f.Origin != gimei.Western || f.Native != nil {
+			t.Fatalf("should be western name: %s", f)
+		}
+		if f.Kanji() != f.Katakana() {
+			t.Fatalf("western name should be registered in katakana: %q", f.Kanji())
+		}
+		if f.Middle != nil && !strings.HasSuffix(f.Katakana(), " "+f.Middle.Katakana()) {
+			t.Fatalf("middle name should be included: %q", f.Katakana())
+		}
+
+		for _, origin := range []gimei.Origin{gimei.Chinese, gimei.Korean} {
+			f = gimei.NewForeignNameOf(origin)
+			if f.Origin != origin || f.Native == nil || f.Middle != nil {
+				t.Fatalf("should be %s name: %s", origin, f)
+			}
+			if f.Native.Kanji() != f.Kanji() {
+				t.Fatalf("native name should have same kanji: %s != %s", f.Native, f)
+			}
+			if f.Romaji() != f.Native.Romaji() {
+				t.Fatalf("romaji should be native reading: %q", f.Romaji())
+			}
+		}
+	}
+	if f := gimei.NewForeignNameOf(0); f != nil {
+		t.Fatalf("unknown origin should return nil: %s", f)
+	}
+}
@@ -0,0 +1,75 @@
+package gimei
+
+import (
+	"strings"
+)
+
+// list of layout for Name.Format
+const (
+	KanjiLayout     = "{last} {first}"                   // 小林 顕士
+	FullWidthLayout = "{last}　{first}"                   // 小林　顕士
+	HonorificLayout = "{last}　{first} 様"                 // 小林　顕士 様
+	KatakanaLayout  = "{last.katakana}　{first.katakana}" // コバヤシ　ケンジ
+	RomajiLayout    = "{first.romaji} {last.romaji}"     // Kenji Kobayashi
+	PassportLayout  = "{last.ROMAJI} {first.ROMAJI}"     // KOBAYASHI KENJI
+	LastCommaLayout = "{last.romaji}, {first.romaji}"    // Kobayashi, Kenji
+)
+
+// Format return string of Name formatted by layout. The layout is a text with
+// fields in braces such as "{last}　{first} 様". Fields are {last}, {first} and
+// {maiden} (empty if last name was never changed). The script can be specified
+// as {last.katakana}, and the scripts are kanji, hiragana, katakana, romaji and
+// ROMAJI that is romaji in capital letters. Unknown fields are left as is.
+func (n *Name) Format(layout string) string {
+	var b strings.Builder
+	for {
+		i := strings.IndexByte(layout, '{')
+		if i < 0 {
+			break
+		}
+		j := strings.IndexByte(layout[i:], '}')
+		if j < 0 {
+			break
+		}
+		b.WriteString(layout[:i])
+		if s, ok := n.formatField(layout[i+1 : i+j]); ok {
+			b.WriteString(s)
+		} else {
+			b.WriteString(layout[i : i+j+1])
+		}
+		layout = layout[i+j+1:]
+	}
+	b.WriteString(layout)
+	return b.String()
+}
+
+func (n *Name) formatField(field string) (string, bool) {
+	script := "kanji"
+	if i := strings.IndexByte(field, '.'); i >= 0 {
+		field, script = field[:i], field[i+1:]
+	}
+	var item Item
+	switch field {
+	case "last":
+		item = n.Last
+	case "first":
+		item = n.First
+	case "maiden":
+		item = n.MaidenName()
+	default:
+		return "", false
+	}
+	switch script {
+	case "kanji":
+		return item.Kanji(), true
+	case "hiragana":
+		return item.Hiragana(), true
+	case "katakana":
+		return item.Katakana(), true
+	case "romaji":
+		return item.Romaji(), true
+	case "ROMAJI":
+		return strings.ToUpper(item.Romaji()), true
+	}
+	return "", false
+}
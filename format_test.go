@@ -0,0 +1,47 @@
+package gimei_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestFormat(t *testing.T) {
+	name := &gimei.Name{
+		First: gimei.Item{"顕士", "けんじ", "ケンジ", "kenji"},
+		Last:  gimei.Item{"小林", "こばやし", "コバヤシ", "kobayashi"},
+		Sex:   gimei.Male,
+	}
+	tests := []struct {
+		layout string
+		want   string
+	}{
+		{gimei.KanjiLayout, "小林 顕士"},
+		{gimei.FullWidthLayout, "小林　顕士"},
+		{gimei.HonorificLayout, "小林　顕士 様"},
+		{gimei.KatakanaLayout, "コバヤシ　ケンジ"},
+		{gimei.RomajiLayout, "Kenji Kobayashi"},
+		{gimei.PassportLayout, "KOBAYASHI KENJI"},
+		{gimei.LastCommaLayout, "Kobayashi, Kenji"},
+		{"{last.ROMAJI} {first.romaji}", "KOBAYASHI Kenji"},
+		{"{last.hiragana}さん", "こばやしさん"},
+		{"{last}殿", "小林殿"},
+		{"{maiden}{last}", "小林"},
+		{"{unknown} {last.unknown} {first", "{unknown} {last.unknown} {first"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		if got := name.Format(test.layout); got != test.want {
+			t.Fatalf("Format(%q): want %q but %q", test.layout, test.want, got)
+		}
+	}
+
+	name.History = []gimei.NameChange{{Last: gimei.Item{"佐藤", "さとう", "サトウ", "sato"}, Date: time.Now()}}
+	if got := name.Format("{last}({maiden}) {first}"); got != "小林(佐藤) 顕士" {
+		t.Fatalf("want %q but %q", "小林(佐藤) 顕士", got)
+	}
+	if name.Format(gimei.KanjiLayout) != name.Kanji() || name.Format(gimei.RomajiLayout) != name.Romaji() {
+		t.Fatal("layouts should be compatible with Kanji and Romaji")
+	}
+}
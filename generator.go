@@ -0,0 +1,176 @@
+package gimei
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// Generator generate fake data with its own random source. It does not change
+// the random source set by SetRandom, so generators seeded with the same value
+// generate the same data even if other goroutines use the package functions.
+// Generators do not share the lock, so use a generator per goroutine to
+// generate many data in parallel.
+//
+// Generator copies the settings of SetSexRatio and SetPopulationWeighted when
+// it is created, and the package functions do not change them later. Use the
+// methods of Generator to change them.
+type Generator struct {
+	mu   sync.Mutex
+	rand *rand.Rand
+	settings
+}
+
+// NewGenerator return new instance of Generator that uses rnd.
+func NewGenerator(rnd *rand.Rand) *Generator {
+	return &Generator{rand: rnd, settings: loadSettings()}
+}
+
+// SetSexRatio set ratio of male, female and other that the generator picks.
+// See SetSexRatio.
+func (g *Generator) SetSexRatio(male, female, other int) {
+	checkSexRatio(male, female, other)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.sexRatio = [3]int{male, female, other}
+}
+
+// SetPopulationWeighted set whether the generator picks prefecture and city
+// weighted by the population. See SetPopulationWeighted.
+func (g *Generator) SetPopulationWeighted(weighted bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.populationWeighted = weighted
+}
+
+// picker return picker with the random source and the settings of g. g.mu
+// must be locked.
+func (g *Generator) picker() picker {
+	return picker{rand: g.rand, settings: g.settings}
+}
+
+// NewName return new instance of person. See NewName.
+func (g *Generator) NewName() *Name {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().name()
+}
+
+// NewMale return new instance of person that is male.
+func (g *Generator) NewMale() *Name {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().nameOf(Male)
+}
+
+// NewFemale return new instance of person that is female.
+func (g *Generator) NewFemale() *Name {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().nameOf(Female)
+}
+
+// NewDog return new instance of person whose last name begins "inu".
+func (g *Generator) NewDog() *Name {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().pet("dog", Unspecified)
+}
+
+// NewCat return new instance of person whose last name begins "neko".
+func (g *Generator) NewCat() *Name {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().pet("cat", Unspecified)
+}
+
+// NewMaleDog return new instance of male person whose last name begins "inu".
+func (g *Generator) NewMaleDog() *Name {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().pet("dog", Male)
+}
+
+// NewFemaleDog return new instance of female person whose last name begins "inu".
+func (g *Generator) NewFemaleDog() *Name {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().pet("dog", Female)
+}
+
+// NewMaleCat return new instance of male person whose last name begins "neko".
+func (g *Generator) NewMaleCat() *Name {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().pet("cat", Male)
+}
+
+// NewFemaleCat return new instance of female person whose last name begins "neko".
+func (g *Generator) NewFemaleCat() *Name {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().pet("cat", Female)
+}
+
+// NewAddress return new instance of address. See NewAddress.
+func (g *Generator) NewAddress() *Address {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().address()
+}
+
+// NewPrefecture return new instance of prefecture.
+func (g *Generator) NewPrefecture() Item {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().prefecture()
+}
+
+// NewCity return new instance of city.
+func (g *Generator) NewCity() Item {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().city()
+}
+
+// NewTown return new instance of town.
+func (g *Generator) NewTown() Item {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().town()
+}
+
+// NewWardAddress return new instance of address that is in a ward of
+// designated city. See NewWardAddress.
+func (g *Generator) NewWardAddress() *Address {
+	return g.NewAddressWith(func(a *Address) bool { return a.Ward() != nil })
+}
+
+// NewVillageAddress return new instance of address that is in a village. See
+// NewVillageAddress.
+func (g *Generator) NewVillageAddress() *Address {
+	return g.NewAddressWith((*Address).IsVillage)
+}
+
+// NewPostalCode return new instance of postal code.
+func (g *Generator) NewPostalCode() *PostalCode {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().postalCode()
+}
@@ -0,0 +1,106 @@
+package gimei_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestGenerator(t *testing.T) {
+	generate := func(g *gimei.Generator) []string {
+		var list []string
+		for i := 0; i < 10; i++ {
+			list = append(list, g.NewName().Kanji(), g.NewMale().Kanji(), g.NewFemale().Kanji(),
+				g.NewAddress().Kanji(), g.NewPrefecture().Kanji(), g.NewCity().Kanji(), g.NewTown().Kanji(),
+				g.NewPostalCode().Kanji(), g.NewNeutralName().Kanji(), g.NewNameIn("沖縄県").Kanji(),
+				g.NewAddressIn("北海道").Kanji(), g.NewAddressInRegion(gimei.Kinki).Kanji(),
+				g.NewWardAddress().Kanji(), g.NewVillageAddress().Kanji(),
+				g.NewHousehold().String(), g.NewCommonLawHousehold().String(),
+				g.NewNameWithMaidenName().KanjiWithMaidenName(), g.NewForeignName().Kanji(),
+				g.NewForeignNameOf(gimei.Korean).Kanji(), g.NewDog().Kanji(), g.NewCat().Kanji(),
+				g.NewMaleDog().Kanji(), g.NewFemaleDog().Kanji(), g.NewMaleCat().Kanji(), g.NewFemaleCat().Kanji(),
+				g.JitteredLocation(g.NewAddress(), 10).String())
+		}
+		return list
+	}
+	want := generate(gimei.NewGenerator(rand.New(rand.NewSource(1))))
+
+	t.Run("group", func(t *testing.T) {
+		for i := 0; i < 8; i++ {
+			t.Run("parallel", func(t *testing.T) {
+				t.Parallel()
+				g := gimei.NewGenerator(rand.New(rand.NewSource(1)))
+				var got []string
+				for j := 0; j < 10; j++ {
+					gimei.NewName()
+					gimei.NewAddress()
+					gimei.NewHousehold()
+					gimei.NewForeignName()
+					got = append(got, generate(g)...)
+				}
+				if !reflect.DeepEqual(got[:len(want)], want) {
+					t.Errorf("generator should not be affected by others")
+				}
+			})
+		}
+	})
+}
+
+func TestGeneratorKeepGlobalRandom(t *testing.T) {
+	defer gimei.SetRandom(rand.New(rand.NewSource(rand.Int63())))
+
+	gimei.SetRandom(rand.New(rand.NewSource(42)))
+	want := []string{gimei.NewName().Kanji(), gimei.NewAddress().Kanji()}
+
+	gimei.SetRandom(rand.New(rand.NewSource(42)))
+	g := gimei.NewGenerator(rand.New(rand.NewSource(1)))
+	got := []string{gimei.NewName().Kanji()}
+	g.NewName()
+	g.NewAddress()
+	g.NewAddressWith()
+	g.NewHousehold()
+	g.NewNameWithMaidenName()
+	g.NewForeignName()
+	g.NewDog()
+	got = append(got, gimei.NewAddress().Kanji())
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %v but %v", want, got)
+	}
+}
+
+func TestGeneratorSettings(t *testing.T) {
+	defer gimei.SetSexRatio(1, 1, 0)
+	defer gimei.SetPopulationWeighted(false)
+
+	g := gimei.NewGenerator(rand.New(rand.NewSource(1)))
+	want := gimei.NewGenerator(rand.New(rand.NewSource(1))).NewNames(100)
+
+	// package settings changed after creation do not affect the generator
+	gimei.SetSexRatio(0, 1, 0)
+	gimei.SetPopulationWeighted(true)
+	for i, n := range g.NewNames(100) {
+		if n.Kanji() != want[i].Kanji() || n.Sex != want[i].Sex {
+			t.Fatalf("want %v but %v", want[i], n)
+		}
+	}
+
+	// but they are copied when it is created
+	g = gimei.NewGenerator(rand.New(rand.NewSource(1)))
+	for _, n := range g.NewNames(100) {
+		if n.Sex != gimei.Female {
+			t.Fatalf("generator should copy the sex ratio: %v", n.Sex)
+		}
+	}
+
+	g.SetSexRatio(1, 0, 0)
+	for _, n := range g.NewNames(100) {
+		if n.Sex != gimei.Male {
+			t.Fatalf("generator should use its sex ratio: %v", n.Sex)
+		}
+	}
+	if gimei.NewName().Sex != gimei.Female {
+		t.Fatal("generator should not change the package settings")
+	}
+}
@@ -14,7 +14,7 @@ import (
 )
 
 var (
-	//go:embed data/addresses.yml data/names.yml data/postalcodes.yml
+	//go:embed data/addresses.yml data/names.yml data/postalcodes.yml data/prefectures.yml data/municipalitycodes.yml data/surnames.yml data/eranames.yml data/foreignnames.yml data/populations.yml
 	assets embed.FS
 
 	names       name
@@ -29,8 +29,11 @@ var (
 	lastNameIndex        [4]map[string]Item
 	maleFirstNameIndex   [4]map[string]Item
 	femaleFirstNameIndex [4]map[string]Item
+	neutralFirstNames    map[string]bool
 	cityIndex            [3]map[string]Item
 	townIndex            [3]map[string]Item
+	cityPrefecture       []Item
+	islandCities         map[string]bool
 )
 
 // Item take four figure for japanese. Kanji/Hiragana/Katakana/Romaji.
@@ -44,16 +47,25 @@ func (i Item) String() string {
 
 // Kanji return string of Item as kanji.
 func (i Item) Kanji() string {
+	if len(i) == 0 {
+		return ""
+	}
 	return i[0]
 }
 
 // Hiragana return string of Item as hiragana.
 func (i Item) Hiragana() string {
+	if len(i) <= 1 {
+		return ""
+	}
 	return i[1]
 }
 
 // Katakana return string of Item as katakana.
 func (i Item) Katakana() string {
+	if len(i) <= 2 {
+		return ""
+	}
 	return i[2]
 }
 
@@ -65,7 +77,7 @@ func (i Item) Romaji() string {
 	return cases.Title(language.Und, cases.NoLower).String(i[3])
 }
 
-// Sex store Male or Female.
+// Sex store sex of person. The values are same as codes of ISO/IEC 5218.
 type Sex int
 
 // String implement Stringer.
@@ -75,22 +87,27 @@ func (s Sex) String() string {
 		return "男"
 	case Female:
 		return "女"
+	case Other:
+		return "その他"
 	}
 	return "？"
 }
 
 // list of sex
 const (
-	Male   Sex = iota + 1 // 男
-	Female                // 女
+	Unspecified Sex = 0 // not known
+	Male        Sex = 1 // 男
+	Female      Sex = 2 // 女
+	Other       Sex = 9 // not applicable
 )
 
 // name store data sturecture just same as names.yml.
 type name struct {
 	FirstName struct {
-		Male   []Item `yaml:"male"`
-		Female []Item `yaml:"female"`
-		Animal []Item `yaml:"animal"`
+		Male    []Item `yaml:"male"`
+		Female  []Item `yaml:"female"`
+		Animal  []Item `yaml:"animal"`
+		Neutral []Item `yaml:"neutral"`
 	} `yaml:"first_name"`
 	LastName    []Item `yaml:"last_name"`
 	LastNameDog []Item `yaml:"last_name_dog"`
@@ -99,9 +116,10 @@ type name struct {
 
 // Name store name and sex for a person.
 type Name struct {
-	First Item
-	Last  Item
-	Sex   Sex
+	First   Item
+	Last    Item
+	Sex     Sex
+	History []NameChange // changes of last name in order of date. It is empty if never changed.
 }
 
 func init() {
@@ -162,6 +180,15 @@ func buildNameIndex() {
 			}
 		}
 	}
+	neutralFirstNames = make(map[string]bool, len(names.FirstName.Neutral))
+	for _, item := range names.FirstName.Neutral {
+		_, male := maleFirstNameIndex[0][item.Kanji()]
+		_, female := femaleFirstNameIndex[0][item.Kanji()]
+		if !male && !female {
+			panic("unknown first name in neutral names: " + item.Kanji())
+		}
+		neutralFirstNames[item.Kanji()+" "+item.Hiragana()] = true
+	}
 }
 
 // String implement Stringer.
@@ -199,25 +226,13 @@ func (n *Name) IsFemale() bool {
 	return n.Sex == Female
 }
 
-// NewName return new instance of person.
+// NewName return new instance of person. Sex is picked by the ratio set by
+// SetSexRatio.
 func NewName() *Name {
 	mu.Lock()
 	defer mu.Unlock()
 
-	onceName.Do(loadNames)
-	if r.Intn(2) == 0 {
-		return &Name{
-			First: names.FirstName.Male[r.Intn(len(names.FirstName.Male))],
-			Last:  names.LastName[r.Intn(len(names.LastName))],
-			Sex:   Male,
-		}
-	} else {
-		return &Name{
-			First: names.FirstName.Female[r.Intn(len(names.FirstName.Female))],
-			Last:  names.LastName[r.Intn(len(names.LastName))],
-			Sex:   Female,
-		}
-	}
+	return global().name()
 }
 
 // NewDog return new instance of person whose last name begins "inu".
@@ -225,11 +240,7 @@ func NewDog() *Name {
 	mu.Lock()
 	defer mu.Unlock()
 
-	onceName.Do(loadNames)
-	return &Name{
-		First: names.FirstName.Animal[r.Intn(len(names.FirstName.Animal))],
-		Last:  names.LastNameDog[r.Intn(len(names.LastNameDog))],
-	}
+	return global().pet("dog", Unspecified)
 }
 
 // NewCat return new instance of person whose last name begins "neko".
@@ -237,11 +248,7 @@ func NewCat() *Name {
 	mu.Lock()
 	defer mu.Unlock()
 
-	onceName.Do(loadNames)
-	return &Name{
-		First: names.FirstName.Animal[r.Intn(len(names.FirstName.Animal))],
-		Last:  names.LastNameCat[r.Intn(len(names.LastNameCat))],
-	}
+	return global().pet("cat", Unspecified)
 }
 
 // NewMale return new instance of person that is male.
@@ -249,12 +256,7 @@ func NewMale() *Name {
 	mu.Lock()
 	defer mu.Unlock()
 
-	onceName.Do(loadNames)
-	return &Name{
-		First: names.FirstName.Male[r.Intn(len(names.FirstName.Male))],
-		Last:  names.LastName[r.Intn(len(names.LastName))],
-		Sex:   Male,
-	}
+	return global().nameOf(Male)
 }
 
 // NewFemale return new instance of person that is female.
@@ -262,12 +264,7 @@ func NewFemale() *Name {
 	mu.Lock()
 	defer mu.Unlock()
 
-	onceName.Do(loadNames)
-	return &Name{
-		First: names.FirstName.Female[r.Intn(len(names.FirstName.Female))],
-		Last:  names.LastName[r.Intn(len(names.LastName))],
-		Sex:   Female,
-	}
+	return global().nameOf(Female)
 }
 
 // NewMaleDog return new instance of male person whose last name begins "inu".
@@ -275,12 +272,7 @@ func NewMaleDog() *Name {
 	mu.Lock()
 	defer mu.Unlock()
 
-	onceName.Do(loadNames)
-	return &Name{
-		First: names.FirstName.Male[r.Intn(len(names.FirstName.Male))],
-		Last:  names.LastNameDog[r.Intn(len(names.LastNameDog))],
-		Sex:   Male,
-	}
+	return global().pet("dog", Male)
 }
 
 // NewFemaleDog return new instance of female person whose last name begins "inu".
@@ -288,12 +280,7 @@ func NewFemaleDog() *Name {
 	mu.Lock()
 	defer mu.Unlock()
 
-	onceName.Do(loadNames)
-	return &Name{
-		First: names.FirstName.Female[r.Intn(len(names.FirstName.Female))],
-		Last:  names.LastNameDog[r.Intn(len(names.LastNameDog))],
-		Sex:   Female,
-	}
+	return global().pet("dog", Female)
 }
 
 // NewMaleCat return new instance of male person whose last name begins "neko".
@@ -301,12 +288,7 @@ func NewMaleCat() *Name {
 	mu.Lock()
 	defer mu.Unlock()
 
-	onceName.Do(loadNames)
-	return &Name{
-		First: names.FirstName.Male[r.Intn(len(names.FirstName.Male))],
-		Last:  names.LastNameCat[r.Intn(len(names.LastNameCat))],
-		Sex:   Male,
-	}
+	return global().pet("cat", Male)
 }
 
 // NewFemaleCat return new instance of female person whose last name begins "neko".
@@ -314,12 +296,7 @@ func NewFemaleCat() *Name {
 	mu.Lock()
 	defer mu.Unlock()
 
-	onceName.Do(loadNames)
-	return &Name{
-		First: names.FirstName.Female[r.Intn(len(names.FirstName.Female))],
-		Last:  names.LastNameCat[r.Intn(len(names.LastNameCat))],
-		Sex:   Female,
-	}
+	return global().pet("cat", Female)
 }
 
 func findNameByIndex(n string, i int) *Name {
@@ -369,8 +346,13 @@ func FindNameByRomaji(romaji string) *Name {
 type address struct {
 	Addresses struct {
 		Prefecture []Item `yaml:"prefecture"`
-		City       []Item `yaml:"city"`
+		City       []Item `yaml:"-"`
 		Town       []Item `yaml:"town"`
+
+		// cities grouped by prefecture in the same order of Prefecture.
+		CityGroup      [][]Item `yaml:"city"`
+		DesignatedCity []Item   `yaml:"designated_city"`
+		Island         []string `yaml:"island"`
 	} `yaml:"addresses"`
 }
 
@@ -397,6 +379,16 @@ func loadAddresses() {
 }
 
 func buildAddressIndex() {
+	for i, prefecture := range addresses.Addresses.Prefecture {
+		for _, city := range addresses.Addresses.CityGroup[i] {
+			addresses.Addresses.City = append(addresses.Addresses.City, city)
+			cityPrefecture = append(cityPrefecture, prefecture)
+		}
+	}
+	islandCities = make(map[string]bool, len(addresses.Addresses.Island))
+	for _, city := range addresses.Addresses.Island {
+		islandCities[city] = true
+	}
 	for i := 0; i < 3; i++ {
 		cityIndex[i] = make(map[string]Item, len(addresses.Addresses.City))
 		for _, item := range addresses.Addresses.City {
@@ -413,6 +405,34 @@ func buildAddressIndex() {
 	}
 }
 
+// splitCity split city into 郡, 市区町村 and 区 of designated city.
+// county and ward are nil if the city does not have them.
+func splitCity(city Item) (county, municipality, ward Item) {
+	kanji, hiragana, katakana := city.Kanji(), city.Hiragana(), city.Katakana()
+	for _, designated := range addresses.Addresses.DesignatedCity {
+		if len(kanji) > len(designated.Kanji()) && strings.HasPrefix(kanji, designated.Kanji()) {
+			ward = Item{
+				kanji[len(designated.Kanji()):],
+				hiragana[len(designated.Hiragana()):],
+				katakana[len(designated.Katakana()):],
+			}
+			return nil, designated, ward
+		}
+	}
+	// 郡山市, 蒲郡市 or 大和郡山市 have 郡 but are not in any county.
+	if k := strings.Index(kanji, "郡"); k > 0 {
+		rest := kanji[k+len("郡"):]
+		if strings.HasSuffix(rest, "町") || strings.HasSuffix(rest, "村") {
+			// county name may contain same sound like 北群馬郡 (きたぐんまぐん).
+			h := strings.LastIndex(hiragana, "ぐん") + len("ぐん")
+			t := strings.LastIndex(katakana, "グン") + len("グン")
+			county = Item{kanji[:k+len("郡")], hiragana[:h], katakana[:t]}
+			return county, Item{rest, hiragana[h:], katakana[t:]}, nil
+		}
+	}
+	return nil, city, nil
+}
+
 func loadPostalCodes() {
 	if b, err := assets.ReadFile("data/postalcodes.yml"); err == nil {
 		if err = yaml.Unmarshal(b, &postalCodes); err == nil {
@@ -442,13 +462,35 @@ func (a *Address) Katakana() string {
 	return a.Prefecture.Katakana() + a.City.Katakana() + a.Town.Katakana()
 }
 
-// NewAddress return new instance of address.
+// County return 郡 of Address. It return nil if the city is not in any county.
+func (a *Address) County() Item {
+	onceAddress.Do(loadAddresses)
+	county, _, _ := splitCity(a.City)
+	return county
+}
+
+// Municipality return 市区町村 of Address. County and ward are not included.
+func (a *Address) Municipality() Item {
+	onceAddress.Do(loadAddresses)
+	_, municipality, _ := splitCity(a.City)
+	return municipality
+}
+
+// Ward return 区 of designated city. It return nil if the city is not a
+// designated city.
+func (a *Address) Ward() Item {
+	onceAddress.Do(loadAddresses)
+	_, _, ward := splitCity(a.City)
+	return ward
+}
+
+// NewAddress return new instance of address. When population weighted mode
+// is enabled, city of the address is in the prefecture.
 func NewAddress() *Address {
-	return &Address{
-		Prefecture: NewPrefecture(),
-		City:       NewCity(),
-		Town:       NewTown(),
-	}
+	mu.Lock()
+	defer mu.Unlock()
+
+	return global().address()
 }
 
 // NewPrefecture return new instance of prefecture.
@@ -456,8 +498,7 @@ func NewPrefecture() Item {
 	mu.Lock()
 	defer mu.Unlock()
 
-	onceAddress.Do(loadAddresses)
-	return addresses.Addresses.Prefecture[r.Intn(len(addresses.Addresses.Prefecture))]
+	return global().prefecture()
 }
 
 // NewTown return new instance of town.
@@ -465,8 +506,7 @@ func NewTown() Item {
 	mu.Lock()
 	defer mu.Unlock()
 
-	onceAddress.Do(loadAddresses)
-	return addresses.Addresses.Town[r.Intn(len(addresses.Addresses.Town))]
+	return global().town()
 }
 
 // NewCity return new instance of city.
@@ -474,8 +514,19 @@ func NewCity() Item {
 	mu.Lock()
 	defer mu.Unlock()
 
-	onceAddress.Do(loadAddresses)
-	return addresses.Addresses.City[r.Intn(len(addresses.Addresses.City))]
+	return global().city()
+}
+
+// NewWardAddress return new instance of address that is in a ward of
+// designated city. The city of the address is in the prefecture.
+func NewWardAddress() *Address {
+	return NewAddressWith(func(a *Address) bool { return a.Ward() != nil })
+}
+
+// NewVillageAddress return new instance of address that is in a village. The
+// village of the address is in the prefecture.
+func NewVillageAddress() *Address {
+	return NewAddressWith((*Address).IsVillage)
 }
 
 func findAddressByIndex(a string, i int) *Address {
@@ -540,10 +591,7 @@ func NewPostalCode() *PostalCode {
 	mu.Lock()
 	defer mu.Unlock()
 
-	oncePostal.Do(loadPostalCodes)
-	return &PostalCode{
-		Code: postalCodes.PostalCodes[r.Intn(len(postalCodes.PostalCodes))],
-	}
+	return global().postalCode()
 }
 
 func CountData() string {
@@ -3,6 +3,7 @@ package gimei_test
 import (
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/mattn/go-gimei"
@@ -140,3 +141,55 @@ func TestPostalCode(t *testing.T) {
 		t.Fatal("PostalCode.String() should not return empty string")
 	}
 }
+
+func TestAddressComponents(t *testing.T) {
+	tests := []struct {
+		address      string
+		county       string
+		municipality string
+		ward         string
+	}{
+		{"岡山県大島郡大和村稲木町", "おおしまぐん", "やまとそん", ""},
+		{"群馬県北群馬郡榛東村稲木町", "きたぐんまぐん", "しんとうむら", ""},
+		{"北海道札幌市中央区モエレ沼公園", "", "さっぽろし", "ちゅうおうく"},
+		{"福島県郡山市稲木町", "", "こおりやまし", ""},
+	}
+	for _, test := range tests {
+		addr := gimei.FindAddressByKanji(test.address)
+		if addr == nil {
+			t.Fatalf("FindAddressByKanji not found: %s", test.address)
+		}
+		if got := addr.County().Hiragana(); got != test.county {
+			t.Errorf("County() of %s == %q, want %q", test.address, got, test.county)
+		}
+		if got := addr.Municipality().Hiragana(); got != test.municipality {
+			t.Errorf("Municipality() of %s == %q, want %q", test.address, got, test.municipality)
+		}
+		if got := addr.Ward().Hiragana(); got != test.ward {
+			t.Errorf("Ward() of %s == %q, want %q", test.address, got, test.ward)
+		}
+	}
+}
+
+func TestWardAndVillageAddress(t *testing.T) {
+	prefectures := map[string]string{}
+	for i := 0; i < 100; i++ {
+		addr := gimei.NewWardAddress()
+		if addr.Ward() == nil {
+			t.Fatalf("NewWardAddress returns address without ward: %s", addr)
+		}
+		// designated city is always in the same prefecture
+		if p, ok := prefectures[addr.Municipality().Kanji()]; ok && p != addr.Prefecture.Kanji() {
+			t.Fatalf("%s is not in %s", addr.Municipality(), addr.Prefecture)
+		}
+		prefectures[addr.Municipality().Kanji()] = addr.Prefecture.Kanji()
+		city := addr.County().Katakana() + addr.Municipality().Katakana() + addr.Ward().Katakana()
+		if city != addr.City.Katakana() {
+			t.Errorf("components of %s == %q, want %q", addr, city, addr.City.Katakana())
+		}
+		addr = gimei.NewVillageAddress()
+		if !strings.HasSuffix(addr.Municipality().Kanji(), "村") {
+			t.Fatalf("NewVillageAddress returns address which is not a village: %s", addr)
+		}
+	}
+}
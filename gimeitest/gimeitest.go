@@ -0,0 +1,60 @@
+// Package gimeitest provides generators of gimei for tests. The generators are
+// seeded per test, and the seed is logged when the test fails so that the
+// failure can be reproduced.
+package gimeitest
+
+import (
+	"flag"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-gimei"
+)
+
+// EnvSeed is name of environment variable to specify seed.
+const EnvSeed = "GIMEI_SEED"
+
+var seed = flag.String("gimei.seed", "", "seed of gimeitest generators (default: $"+EnvSeed+" or random)")
+
+// New return new generator for the test. The generator is seeded by the flag
+// -gimei.seed, environment variable GIMEI_SEED or random value, and the seed
+// is logged when the test fails. It is safe to use in parallel tests because
+// it does not change the random source set by gimei.SetRandom.
+//
+// The generator uses the default settings, sex ratio 1:1:0 and not weighted by
+// the population, so that gimei.SetSexRatio and gimei.SetPopulationWeighted
+// called by other tests do not change what the seed generates. Use the methods
+// of the generator to change them.
+func New(tb testing.TB) *gimei.Generator {
+	tb.Helper()
+
+	s, err := Seed()
+	if err != nil {
+		tb.Fatalf("gimeitest: invalid seed: %v", err)
+	}
+	tb.Cleanup(func() {
+		if tb.Failed() {
+			tb.Logf("gimeitest: seed %d (reproduce with -gimei.seed=%d or %s=%d)", s, s, EnvSeed, s)
+		}
+	})
+	g := gimei.NewGenerator(rand.New(rand.NewSource(s)))
+	g.SetSexRatio(1, 1, 0)
+	g.SetPopulationWeighted(false)
+	return g
+}
+
+// Seed return seed specified by the flag or the environment variable. It
+// return random seed if not specified.
+func Seed() (int64, error) {
+	v := *seed
+	if v == "" {
+		v = os.Getenv(EnvSeed)
+	}
+	if v == "" {
+		return time.Now().UnixNano(), nil
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
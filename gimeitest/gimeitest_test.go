@@ -0,0 +1,97 @@
+package gimeitest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+	"github.com/mattn/go-gimei/gimeitest"
+)
+
+func TestNew(t *testing.T) {
+	t.Setenv(gimeitest.EnvSeed, "12345")
+
+	g1, g2 := gimeitest.New(t), gimeitest.New(t)
+	for i := 0; i < 10; i++ {
+		if n1, n2 := g1.NewName(), g2.NewName(); n1.Kanji() != n2.Kanji() {
+			t.Fatalf("same seed should generate same name: %s != %s", n1, n2)
+		}
+	}
+	if s, err := gimeitest.Seed(); err != nil || s != 12345 {
+		t.Fatalf("want 12345 but %d (%v)", s, err)
+	}
+
+	t.Setenv(gimeitest.EnvSeed, "")
+	if _, err := gimeitest.Seed(); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(gimeitest.EnvSeed, "x")
+	if _, err := gimeitest.Seed(); err == nil {
+		t.Fatal("invalid seed should be error")
+	}
+}
+
+func TestNewIgnorePackageSettings(t *testing.T) {
+	t.Setenv(gimeitest.EnvSeed, "12345")
+
+	var want []string
+	for _, n := range gimeitest.New(t).NewNames(100) {
+		want = append(want, n.Kanji()+n.Sex.String())
+	}
+
+	gimei.SetSexRatio(0, 0, 1)
+	gimei.SetPopulationWeighted(true)
+	defer gimei.SetSexRatio(1, 1, 0)
+	defer gimei.SetPopulationWeighted(false)
+	for i, n := range gimeitest.New(t).NewNames(100) {
+		if got := n.Kanji() + n.Sex.String(); got != want[i] {
+			t.Fatalf("package settings should not change what the seed generates: want %s but %s", want[i], got)
+		}
+	}
+}
+
+// fakeTB record logs and cleanups of test.
+type fakeTB struct {
+	testing.TB
+	failed   bool
+	logs     []string
+	cleanups []func()
+}
+
+func (f *fakeTB) Helper()           {}
+func (f *fakeTB) Failed() bool      { return f.failed }
+func (f *fakeTB) Cleanup(fn func()) { f.cleanups = append(f.cleanups, fn) }
+func (f *fakeTB) Logf(s string, args ...interface{}) {
+	f.logs = append(f.logs, fmt.Sprintf(s, args...))
+}
+
+func TestNewLogSeedOnFailure(t *testing.T) {
+	t.Setenv(gimeitest.EnvSeed, "42")
+
+	for _, failed := range []bool{false, true} {
+		tb := &fakeTB{TB: t, failed: failed}
+		gimeitest.New(tb).NewName()
+		for _, fn := range tb.cleanups {
+			fn()
+		}
+		logged := len(tb.logs) == 1 && strings.Contains(tb.logs[0], "GIMEI_SEED=42")
+		if logged != failed {
+			t.Fatalf("seed should be logged only on failure: failed=%v logs=%q", failed, tb.logs)
+		}
+	}
+}
+
+func TestNewParallel(t *testing.T) {
+	for i := 0; i < 4; i++ {
+		t.Run("parallel", func(t *testing.T) {
+			t.Parallel()
+			g := gimeitest.New(t)
+			for j := 0; j < 100; j++ {
+				if g.NewName() == nil || g.NewAddress() == nil {
+					t.Fatal("generator should return value")
+				}
+			}
+		})
+	}
+}
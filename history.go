@@ -0,0 +1,111 @@
+package gimei
+
+import (
+	"time"
+)
+
+// NameChange store last name before the change and the date of the change.
+type NameChange struct {
+	Last Item      `json:"last" yaml:"last"` // last name before the change
+	Date time.Time `json:"date" yaml:"date"` // date of the change such as marriage
+}
+
+// MaidenName return 旧姓, last name before the latest change. It return nil if
+// last name was never changed.
+func (n *Name) MaidenName() Item {
+	if len(n.History) == 0 {
+		return nil
+	}
+	return n.History[len(n.History)-1].Last
+}
+
+// KanjiWithMaidenName return string of Name as kanji with 旧姓 such as
+// "山田(佐藤) 花子". It is same as Kanji if last name was never changed.
+func (n *Name) KanjiWithMaidenName() string {
+	return withMaidenName(n.Last.Kanji(), n.MaidenName().Kanji(), n.First.Kanji())
+}
+
+// HiraganaWithMaidenName return string of Name as hiragana with 旧姓.
+func (n *Name) HiraganaWithMaidenName() string {
+	return withMaidenName(n.Last.Hiragana(), n.MaidenName().Hiragana(), n.First.Hiragana())
+}
+
+// KatakanaWithMaidenName return string of Name as katakana with 旧姓.
+func (n *Name) KatakanaWithMaidenName() string {
+	return withMaidenName(n.Last.Katakana(), n.MaidenName().Katakana(), n.First.Katakana())
+}
+
+func withMaidenName(last, maiden, first string) string {
+	if maiden == "" {
+		return last + " " + first
+	}
+	return last + "(" + maiden + ") " + first
+}
+
+// NewNameWithMaidenName return new instance of person whose last name was
+// changed by marriage. Most of them are female, and some of them married
+// twice. The dates of the changes are in the past 40 years from the current
+// year, so the dates generated after SetRandom with the same seed differ from
+// year to year. Use NewNameWithMaidenNameAt to generate the same dates.
+func NewNameWithMaidenName() *Name {
+	return NewNameWithMaidenNameAt(time.Now())
+}
+
+// NewNameWithMaidenNameAt return new instance of person whose last name was
+// changed by marriage in the 40 years before the year of t. See
+// NewNameWithMaidenName.
+func NewNameWithMaidenNameAt(t time.Time) *Name {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return global().nameWithMaidenName(t.Year())
+}
+
+// NewNameWithMaidenName return new instance of person whose last name was
+// changed by marriage. See NewNameWithMaidenName.
+func (g *Generator) NewNameWithMaidenName() *Name {
+	return g.NewNameWithMaidenNameAt(time.Now())
+}
+
+// NewNameWithMaidenNameAt return new instance of person whose last name was
+// changed by marriage before the year of t. See NewNameWithMaidenNameAt.
+func (g *Generator) NewNameWithMaidenNameAt(t time.Time) *Name {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().nameWithMaidenName(t.Year())
+}
+
+// nameWithMaidenName return new instance of person whose last name was changed
+// in the 40 years before year.
+func (p picker) nameWithMaidenName(year int) *Name {
+	onceName.Do(loadNames)
+	n := &Name{Sex: Female}
+	if p.rand.Intn(20) == 0 {
+		n.Sex = Male
+	}
+	n.First = p.givenName(n.Sex)
+
+	changes := 1
+	if p.rand.Intn(5) == 0 {
+		changes = 2
+	}
+	year -= 40
+	last := names.LastName[p.rand.Intn(len(names.LastName))]
+	for i := 0; i < changes; i++ {
+		year += 1 + p.rand.Intn(40/changes-1)
+		next := names.LastName[p.rand.Intn(len(names.LastName))]
+		for next.Kanji() == last.Kanji() {
+			next = names.LastName[p.rand.Intn(len(names.LastName))]
+		}
+		n.History = append(n.History, NameChange{Last: last, Date: p.date(year)})
+		last = next
+	}
+	n.Last = last
+	return n
+}
+
+// date return a date in the year.
+func (p picker) date(year int) time.Time {
+	return time.Date(year, time.January, 1+p.rand.Intn(365), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,85 @@
+package gimei_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestNewNameWithMaidenName(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		name := gimei.NewNameWithMaidenName()
+		if len(name.History) == 0 {
+			t.Fatalf("name history should not be empty: %s", name)
+		}
+		last := name.Last
+		for j := len(name.History) - 1; j >= 0; j-- {
+			change := name.History[j]
+			if change.Last.Kanji() == last.Kanji() {
+				t.Fatalf("last name should be changed: %v", name.History)
+			}
+			if j > 0 && !name.History[j-1].Date.Before(change.Date) {
+				t.Fatalf("history should be in order of date: %v", name.History)
+			}
+			last = change.Last
+		}
+		if !name.History[len(name.History)-1].Date.Before(time.Now()) {
+			t.Fatalf("date of change should be in the past: %v", name.History)
+		}
+		if gimei.FindNameByKanji(name.Kanji()) == nil {
+			t.Fatalf("FindNameByKanji not found: %s", name)
+		}
+	}
+}
+
+func TestNewNameWithMaidenNameAt(t *testing.T) {
+	at := time.Date(2000, time.April, 1, 0, 0, 0, 0, time.UTC)
+	g1 := gimei.NewGenerator(rand.New(rand.NewSource(42)))
+	g2 := gimei.NewGenerator(rand.New(rand.NewSource(42)))
+	for i := 0; i < 100; i++ {
+		want, got := g1.NewNameWithMaidenNameAt(at), g2.NewNameWithMaidenNameAt(at)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("same seed should generate same name: want %v but %v", want, got)
+		}
+		for _, change := range got.History {
+			if y := change.Date.Year(); y <= at.Year()-40 || y > at.Year() {
+				t.Fatalf("date of change should be in 40 years before %d: %v", at.Year(), change.Date)
+			}
+		}
+	}
+}
+
+func TestWithMaidenName(t *testing.T) {
+	name := &gimei.Name{
+		First: gimei.Item{"花子", "はなこ", "ハナコ", "Hanako"},
+		Last:  gimei.Item{"山田", "やまだ", "ヤマダ", "Yamada"},
+		Sex:   gimei.Female,
+	}
+	if got := name.KanjiWithMaidenName(); got != "山田 花子" {
+		t.Fatalf("want %q but %q", "山田 花子", got)
+	}
+	if name.MaidenName() != nil {
+		t.Fatalf("maiden name should be nil: %v", name.MaidenName())
+	}
+	name.History = []gimei.NameChange{
+		{Last: gimei.Item{"鈴木", "すずき", "スズキ", "Suzuki"}, Date: time.Date(2005, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{Last: gimei.Item{"佐藤", "さとう", "サトウ", "Sato"}, Date: time.Date(2015, time.June, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	tests := []struct {
+		got  string
+		want string
+	}{
+		{name.KanjiWithMaidenName(), "山田(佐藤) 花子"},
+		{name.HiraganaWithMaidenName(), "やまだ(さとう) はなこ"},
+		{name.KatakanaWithMaidenName(), "ヤマダ(サトウ) ハナコ"},
+		{name.Kanji(), "山田 花子"},
+	}
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Fatalf("want %q but %q", test.want, test.got)
+		}
+	}
+}
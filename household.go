@@ -0,0 +1,307 @@
+package gimei
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	eraNames    []eraName
+	onceEraName sync.Once
+)
+
+// eraNameData store data sturecture just same as eranames.yml.
+type eraNameData struct {
+	EraNames []struct {
+		Decade int        `yaml:"decade"`
+		Male   [][]string `yaml:"male"`
+		Female [][]string `yaml:"female"`
+	} `yaml:"era_names"`
+}
+
+// eraName store popular given names of people born in the decade.
+type eraName struct {
+	decade int
+	male   []Item
+	female []Item
+}
+
+func loadEraNames() {
+	onceName.Do(loadNames)
+	var data eraNameData
+	if b, err := assets.ReadFile("data/eranames.yml"); err == nil {
+		if err = yaml.Unmarshal(b, &data); err == nil {
+			for _, d := range data.EraNames {
+				eraNames = append(eraNames, eraName{
+					decade: d.Decade,
+					male:   findFirstNames(names.FirstName.Male, d.Male),
+					female: findFirstNames(names.FirstName.Female, d.Female),
+				})
+			}
+			return
+		}
+	}
+	panic("failed to load era names data")
+}
+
+func findFirstNames(items []Item, list [][]string) []Item {
+	var found []Item
+next:
+	for _, name := range list {
+		for _, item := range items {
+			if item.Kanji() == name[0] && item.Hiragana() == name[1] {
+				found = append(found, item)
+				continue next
+			}
+		}
+		panic("unknown first name in era names data: " + strings.Join(name, " "))
+	}
+	return found
+}
+
+// eraFirstName return given name of person born in the year. Popular names
+// in the decade are picked for half of people.
+func (p picker) eraFirstName(sex Sex, year int) Item {
+	var era *eraName
+	for i := range eraNames {
+		if eraNames[i].decade <= year || era == nil {
+			era = &eraNames[i]
+		}
+	}
+	if p.rand.Intn(2) != 0 {
+		return p.givenName(sex)
+	}
+	if sex == Male {
+		return era.male[p.rand.Intn(len(era.male))]
+	}
+	return era.female[p.rand.Intn(len(era.female))]
+}
+
+// Relationship is 続柄 of member of household as used on 住民票.
+type Relationship string
+
+// list of relationship
+const (
+	Head             Relationship = "世帯主"
+	Wife             Relationship = "妻"
+	Husband          Relationship = "夫"
+	CommonLawWife    Relationship = "妻(未届)"
+	CommonLawHusband Relationship = "夫(未届)"
+)
+
+var ordinals = []string{"長", "二", "三", "四", "五", "六", "七", "八", "九"}
+
+// childRelationship return relationship of nth (1-origin) son or daughter.
+func childRelationship(sex Sex, nth int) Relationship {
+	if nth > len(ordinals) {
+		return "子"
+	}
+	if sex == Male {
+		return Relationship(ordinals[nth-1] + "男")
+	}
+	return Relationship(ordinals[nth-1] + "女")
+}
+
+// Member store a person in household.
+type Member struct {
+	Name         *Name
+	Relationship Relationship
+	Age          int
+}
+
+// String implement Stringer.
+func (m *Member) String() string {
+	return fmt.Sprintf("%s %s(%d)", m.Relationship, m.Name, m.Age)
+}
+
+// Household store members who share an address and a phone number.
+type Household struct {
+	Address *Address
+	Phone   string
+	Members []*Member
+}
+
+// String implement Stringer.
+func (h *Household) String() string {
+	members := make([]string, len(h.Members))
+	for i, m := range h.Members {
+		members[i] = m.String()
+	}
+	return h.Address.String() + " " + h.Phone + " " + strings.Join(members, ", ")
+}
+
+// Head return 世帯主 of household.
+func (h *Household) Head() *Member {
+	return h.Members[0]
+}
+
+// NewHousehold return new instance of household of married couple and their
+// children. The spouse has the same last name as the head of household, and
+// the last name before the marriage is recorded in History of the Name.
+//
+// Ages and dates of marriage are relative to the current year, so the
+// household generated after SetRandom with the same seed differs from year to
+// year. Use NewHouseholdAt to generate the same household.
+func NewHousehold() *Household {
+	return NewHouseholdAt(time.Now())
+}
+
+// NewHouseholdAt return new instance of household as of t. Ages and dates of
+// marriage are relative to the year of t. See NewHousehold.
+func NewHouseholdAt(t time.Time) *Household {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return global().household(false, t.Year())
+}
+
+// NewCommonLawHousehold return new instance of household of unmarried couple
+// (事実婚) and their children. The spouse has the different last name. See
+// NewHousehold about the current year.
+func NewCommonLawHousehold() *Household {
+	return NewCommonLawHouseholdAt(time.Now())
+}
+
+// NewCommonLawHouseholdAt return new instance of household of unmarried couple
+// as of t. See NewHouseholdAt.
+func NewCommonLawHouseholdAt(t time.Time) *Household {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return global().household(true, t.Year())
+}
+
+// NewHousehold return new instance of household of married couple and their
+// children. See NewHousehold.
+func (g *Generator) NewHousehold() *Household {
+	return g.NewHouseholdAt(time.Now())
+}
+
+// NewHouseholdAt return new instance of household as of t. See
+// NewHouseholdAt.
+func (g *Generator) NewHouseholdAt(t time.Time) *Household {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().household(false, t.Year())
+}
+
+// NewCommonLawHousehold return new instance of household of unmarried couple.
+// See NewCommonLawHousehold.
+func (g *Generator) NewCommonLawHousehold() *Household {
+	return g.NewCommonLawHouseholdAt(time.Now())
+}
+
+// NewCommonLawHouseholdAt return new instance of household of unmarried couple
+// as of t. See NewHouseholdAt.
+func (g *Generator) NewCommonLawHouseholdAt(t time.Time) *Household {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().household(true, t.Year())
+}
+
+// household return new instance of household. Ages are relative to year.
+func (p picker) household(commonLaw bool, year int) *Household {
+	address := p.addressOf(filterCities(nil))
+
+	onceSurname.Do(loadSurnames)
+	onceEraName.Do(loadEraNames)
+
+	prefecture := address.Prefecture.Kanji()
+	h := &Household{
+		Address: address,
+		Phone:   p.phoneNumber(FindPrefectureByKanji(prefecture).AreaCode),
+	}
+
+	headSex, spouseSex := Male, Female
+	relationship := Wife
+	if p.rand.Intn(10) == 0 {
+		headSex, spouseSex = Female, Male
+		relationship = Husband
+	}
+	headAge := 25 + p.rand.Intn(41)
+	spouseAge := headAge - 4 + p.rand.Intn(9)
+	if spouseAge < 20 {
+		spouseAge = 20
+	}
+	last := p.surname(prefecture)
+	spouseLast := p.surname(prefecture)
+	for spouseLast.Kanji() == last.Kanji() {
+		spouseLast = p.surname(prefecture)
+	}
+
+	head := &Member{
+		Name:         &Name{First: p.eraFirstName(headSex, year-headAge), Last: last, Sex: headSex},
+		Relationship: Head,
+		Age:          headAge,
+	}
+	spouse := &Member{
+		Name:         &Name{First: p.eraFirstName(spouseSex, year-spouseAge), Last: last, Sex: spouseSex},
+		Relationship: relationship,
+		Age:          spouseAge,
+	}
+	if commonLaw {
+		spouse.Name.Last = spouseLast
+		spouse.Relationship = CommonLawWife
+		if spouseSex == Male {
+			spouse.Relationship = CommonLawHusband
+		}
+	}
+	h.Members = append(h.Members, head, spouse)
+
+	motherAge := spouseAge
+	if headSex == Female {
+		motherAge = headAge
+	}
+	childAge := motherAge - 24 - p.rand.Intn(12)
+	if !commonLaw {
+		// married before the first child was born, and after both became 20.
+		married := 0
+		if childAge > 0 {
+			married = childAge
+		}
+		married += p.rand.Intn(3)
+		if married > headAge-20 {
+			married = headAge - 20
+		}
+		if married > spouseAge-20 {
+			married = spouseAge - 20
+		}
+		spouse.Name.History = []NameChange{{Last: spouseLast, Date: p.date(year - married)}}
+	}
+	var sons, daughters int
+	for n := []int{0, 0, 1, 1, 1, 2, 2, 2, 2, 3}[p.rand.Intn(10)]; n > 0 && childAge >= 0; n-- {
+		sex := Sex(1 + p.rand.Intn(2))
+		var nth int
+		if sex == Male {
+			sons++
+			nth = sons
+		} else {
+			daughters++
+			nth = daughters
+		}
+		h.Members = append(h.Members, &Member{
+			Name:         &Name{First: p.eraFirstName(sex, year-childAge), Last: last, Sex: sex},
+			Relationship: childRelationship(sex, nth),
+			Age:          childAge,
+		})
+		childAge -= 1 + p.rand.Intn(5)
+	}
+	return h
+}
+
+// phoneNumber return phone number of fixed-line in the area.
+func (p picker) phoneNumber(areaCode string) string {
+	// phone numbers are 10 digits including the area code.
+	var local strings.Builder
+	local.WriteByte(byte('2' + p.rand.Intn(8)))
+	for i := len(areaCode) + 1; i < 6; i++ {
+		local.WriteByte(byte('0' + p.rand.Intn(10)))
+	}
+	return fmt.Sprintf("%s-%s-%04d", areaCode, local.String(), p.rand.Intn(10000))
+}
@@ -0,0 +1,77 @@
+package gimei_test
+
+import (
+	"math/rand"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestNewHousehold(t *testing.T) {
+	phone := regexp.MustCompile(`^0\d{1,3}-\d{1,4}-\d{4}$`)
+	for i := 0; i < 100; i++ {
+		h := gimei.NewHousehold()
+		if len(h.Members) < 2 {
+			t.Fatalf("household should have a couple: %s", h)
+		}
+		if len(phone.FindString(h.Phone)) != 12 {
+			t.Fatalf("invalid phone number: %q", h.Phone)
+		}
+		head, spouse := h.Head(), h.Members[1]
+		if head.Relationship != gimei.Head {
+			t.Fatalf("first member should be head: %s", h)
+		}
+		if spouse.Name.Last.Kanji() != head.Name.Last.Kanji() {
+			t.Fatalf("spouse should have same last name: %s", h)
+		}
+		if spouse.Name.MaidenName() == nil || spouse.Name.MaidenName().Kanji() == head.Name.Last.Kanji() {
+			t.Fatalf("maiden name of spouse should be recorded: %s", h)
+		}
+		for _, child := range h.Members[2:] {
+			if child.Age < 0 || child.Age >= head.Age || child.Name.Last.Kanji() != head.Name.Last.Kanji() {
+				t.Fatalf("invalid child %s: %s", child, h)
+			}
+			if gimei.FindNameByKanji(child.Name.Kanji()) == nil {
+				t.Fatalf("FindNameByKanji not found: %s", child.Name)
+			}
+		}
+	}
+}
+
+func TestNewCommonLawHousehold(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		h := gimei.NewCommonLawHousehold()
+		spouse := h.Members[1]
+		if spouse.Name.Last.Kanji() == h.Head().Name.Last.Kanji() || spouse.Name.MaidenName() != nil {
+			t.Fatalf("spouse should have different last name: %s", h)
+		}
+		if spouse.Relationship != gimei.CommonLawWife && spouse.Relationship != gimei.CommonLawHusband {
+			t.Fatalf("relationship of spouse == %s: %s", spouse.Relationship, h)
+		}
+	}
+}
+
+func TestNewHouseholdAt(t *testing.T) {
+	defer gimei.SetRandom(rand.New(rand.NewSource(rand.Int63())))
+
+	at := time.Date(2000, time.April, 1, 0, 0, 0, 0, time.UTC)
+	gimei.SetRandom(rand.New(rand.NewSource(42)))
+	want := []string{gimei.NewHouseholdAt(at).String(), gimei.NewCommonLawHouseholdAt(at).String()}
+	gimei.SetRandom(rand.New(rand.NewSource(42)))
+	got := []string{gimei.NewHouseholdAt(at).String(), gimei.NewCommonLawHouseholdAt(at).String()}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("same seed should generate same household: want %q but %q", want[i], got[i])
+		}
+	}
+
+	g := gimei.NewGenerator(rand.New(rand.NewSource(1)))
+	for i := 0; i < 100; i++ {
+		h := g.NewHouseholdAt(at)
+		if d := h.Members[1].Name.History[0].Date; d.Year() > at.Year() || d.Year() < at.Year()-h.Head().Age {
+			t.Fatalf("date of marriage should be relative to %d: %v", at.Year(), d)
+		}
+	}
+}
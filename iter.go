@@ -0,0 +1,75 @@
+//go:build go1.23
+// +build go1.23
+
+package gimei
+
+import (
+	"iter"
+)
+
+// Names return iterator of n new instances of person. The lock is taken for
+// each person, so other goroutines can generate while iterating.
+func Names(n int) iter.Seq[*Name] {
+	return func(yield func(*Name) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(NewName()) {
+				return
+			}
+		}
+	}
+}
+
+// Addresses return iterator of n new instances of address.
+func Addresses(n int) iter.Seq[*Address] {
+	return func(yield func(*Address) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(NewAddress()) {
+				return
+			}
+		}
+	}
+}
+
+// PostalCodes return iterator of n new instances of postal code.
+func PostalCodes(n int) iter.Seq[*PostalCode] {
+	return func(yield func(*PostalCode) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(NewPostalCode()) {
+				return
+			}
+		}
+	}
+}
+
+// Names return iterator of n new instances of person.
+func (g *Generator) Names(n int) iter.Seq[*Name] {
+	return func(yield func(*Name) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(g.NewName()) {
+				return
+			}
+		}
+	}
+}
+
+// Addresses return iterator of n new instances of address.
+func (g *Generator) Addresses(n int) iter.Seq[*Address] {
+	return func(yield func(*Address) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(g.NewAddress()) {
+				return
+			}
+		}
+	}
+}
+
+// PostalCodes return iterator of n new instances of postal code.
+func (g *Generator) PostalCodes(n int) iter.Seq[*PostalCode] {
+	return func(yield func(*PostalCode) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(g.NewPostalCode()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+//go:build go1.23
+// +build go1.23
+
+package gimei_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestIterators(t *testing.T) {
+	count := 0
+	for n := range gimei.Names(5) {
+		if n == nil {
+			t.Fatal("name should not be nil")
+		}
+		count++
+	}
+	for a := range gimei.Addresses(5) {
+		if a == nil {
+			t.Fatal("address should not be nil")
+		}
+		count++
+	}
+	for p := range gimei.PostalCodes(5) {
+		if p == nil {
+			t.Fatal("postal code should not be nil")
+		}
+		count++
+	}
+	if count != 15 {
+		t.Fatalf("want 15 but %d", count)
+	}
+
+	for range gimei.Names(100) {
+		break
+	}
+
+	g1 := gimei.NewGenerator(rand.New(rand.NewSource(42)))
+	g2 := gimei.NewGenerator(rand.New(rand.NewSource(42)))
+	want := g1.NewNames(5)
+	i := 0
+	for n := range g2.Names(5) {
+		if n.Kanji() != want[i].Kanji() {
+			t.Errorf("want %q but %q", want[i], n)
+		}
+		i++
+	}
+	for a := range g2.Addresses(1) {
+		if a.Kanji() != g1.NewAddress().Kanji() {
+			t.Error("iterator should use generator")
+		}
+	}
+	for p := range g2.PostalCodes(1) {
+		if p.Kanji() != g1.NewPostalCode().Kanji() {
+			t.Error("iterator should use generator")
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package gimei
+
+import (
+	"hash/fnv"
+	"math/rand"
+)
+
+// splitMix is SplitMix64, the small random source derived from key or index.
+// It is cheap to create unlike the source of rand.NewSource.
+type splitMix uint64
+
+func (s *splitMix) Uint64() uint64 {
+	*s += 0x9e3779b97f4a7c15
+	z := uint64(*s)
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+func (s *splitMix) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+func (s *splitMix) Seed(seed int64) {
+	*s = splitMix(seed)
+}
+
+// keyRand return random source derived from kind and key.
+func keyRand(kind, key string) *rand.Rand {
+	h := fnv.New64a()
+	h.Write([]byte(kind))
+	h.Write([]byte{0})
+	h.Write([]byte(key))
+	s := splitMix(h.Sum64())
+	return rand.New(&s)
+}
+
+// indexRand return random source derived from kind, seed and index.
+func indexRand(kind string, seed int64, i int) *rand.Rand {
+	h := fnv.New64a()
+	h.Write([]byte(kind))
+	s := splitMix(h.Sum64() ^ uint64(seed))
+	s = splitMix(s.Uint64() ^ uint64(i))
+	return rand.New(&s)
+}
+
+// NameFor return person for the key. The same key always return the same
+// person regardless of the order of calls, other goroutines and SetRandom.
+// It depends on settings such as SetSexRatio.
+func NameFor(key string) *Name {
+	return newPicker(keyRand("name", key)).name()
+}
+
+// AddressFor return address for the key. See NameFor.
+func AddressFor(key string) *Address {
+	return newPicker(keyRand("address", key)).address()
+}
+
+// NameForIndex return i-th person of the infinite dataset specified by the
+// seed. Ranges of the dataset can be generated in parallel.
+func NameForIndex(seed int64, i int) *Name {
+	return newPicker(indexRand("name", seed, i)).name()
+}
+
+// AddressForIndex return i-th address of the infinite dataset specified by
+// the seed. See NameForIndex.
+func AddressForIndex(seed int64, i int) *Address {
+	return newPicker(indexRand("address", seed, i)).address()
+}
@@ -0,0 +1,69 @@
+package gimei_test
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestNameFor(t *testing.T) {
+	want := gimei.NameFor("user-42").Kanji()
+	wantAddress := gimei.AddressFor("user-42").Kanji()
+
+	gimei.SetRandom(rand.New(rand.NewSource(1)))
+	defer gimei.SetRandom(rand.New(rand.NewSource(rand.Int63())))
+	gimei.NameFor("user-1")
+	gimei.NewName()
+	if got := gimei.NameFor("user-42").Kanji(); got != want {
+		t.Fatalf("want %s but %s", want, got)
+	}
+	if got := gimei.AddressFor("user-42").Kanji(); got != wantAddress {
+		t.Fatalf("want %s but %s", wantAddress, got)
+	}
+
+	differ := false
+	for i := 0; i < 10 && !differ; i++ {
+		differ = gimei.NameFor(string(rune('a'+i))).Kanji() != want
+	}
+	if !differ {
+		t.Fatal("different key should return different name")
+	}
+}
+
+func TestNameForIndex(t *testing.T) {
+	const n = 100
+	want := make([]string, n)
+	for i := range want {
+		want[i] = gimei.NameForIndex(42, i).Kanji() + gimei.AddressForIndex(42, i).Kanji()
+	}
+
+	got := make([]string, n)
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += 25 {
+		wg.Add(1)
+		go func(start int) {
+			defer wg.Done()
+			for i := start + 24; i >= start; i-- {
+				got[i] = gimei.NameForIndex(42, i).Kanji() + gimei.AddressForIndex(42, i).Kanji()
+			}
+		}(start)
+	}
+	wg.Wait()
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("%d: want %s but %s", i, want[i], got[i])
+		}
+	}
+
+	same := 0
+	for i := 0; i < 10; i++ {
+		if gimei.NameForIndex(1, i).Kanji() == gimei.NameForIndex(2, i).Kanji() {
+			same++
+		}
+	}
+	if same == 10 {
+		t.Fatal("different seed should return different names")
+	}
+}
@@ -0,0 +1,92 @@
+package gimei
+
+import (
+	"fmt"
+	"math"
+)
+
+// radius of the earth in kilometers
+const earthRadius = 6371.0
+
+// Location store latitude and longitude in degrees.
+type Location struct {
+	Latitude  float64 `json:"latitude" yaml:"latitude"`
+	Longitude float64 `json:"longitude" yaml:"longitude"`
+}
+
+// String implement Stringer.
+func (l Location) String() string {
+	return fmt.Sprintf("%.4f,%.4f", l.Latitude, l.Longitude)
+}
+
+// Distance return great-circle distance to m in kilometers.
+func (l Location) Distance(m Location) float64 {
+	lat1, lat2 := l.Latitude*math.Pi/180, m.Latitude*math.Pi/180
+	dlat := lat2 - lat1
+	dlon := (m.Longitude - l.Longitude) * math.Pi / 180
+	h := math.Sin(dlat/2)*math.Sin(dlat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dlon/2)*math.Sin(dlon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+// Location return approximate location of Address. Location of each city is
+// not embedded yet, so it is the location of prefectural office. It return
+// zero Location if the prefecture is unknown.
+func (a *Address) Location() Location {
+	if p := FindPrefectureByKanji(a.Prefecture.Kanji()); p != nil {
+		return p.Location
+	}
+	return Location{}
+}
+
+// JitteredLocation return location of Address that is moved randomly within
+// km kilometers.
+func (a *Address) JitteredLocation(km float64) Location {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return global().jitter(a.Location(), km)
+}
+
+// JitteredLocation return location of Address that is moved randomly within
+// km kilometers. See (*Address).JitteredLocation.
+func (g *Generator) JitteredLocation(a *Address, km float64) Location {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().jitter(a.Location(), km)
+}
+
+// jitter return l moved randomly within km kilometers.
+func (p picker) jitter(l Location, km float64) Location {
+	d := km * math.Sqrt(p.rand.Float64())
+	theta := 2 * math.Pi * p.rand.Float64()
+
+	// destination point on the sphere at distance d and bearing theta
+	lat1, lon1 := l.Latitude*math.Pi/180, l.Longitude*math.Pi/180
+	delta := d / earthRadius
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(delta) + math.Cos(lat1)*math.Sin(delta)*math.Cos(theta))
+	lon2 := lon1 + math.Atan2(math.Sin(theta)*math.Sin(delta)*math.Cos(lat1), math.Cos(delta)-math.Sin(lat1)*math.Sin(lat2))
+	return Location{Latitude: lat2 * 180 / math.Pi, Longitude: lon2 * 180 / math.Pi}
+}
+
+// FindNearestCity find Address of the city that has the prefectural office
+// nearest to l. Location of each city is not embedded yet, so it is one of 47
+// cities that have prefectural offices, not the nearest municipality. Town of
+// the Address is empty.
+func FindNearestCity(l Location) *Address {
+	var nearest *Prefecture
+	for _, p := range Prefectures() {
+		if nearest == nil || l.Distance(p.Location) < l.Distance(nearest.Location) {
+			nearest = p
+		}
+	}
+	onceAddress.Do(loadAddresses)
+	for i, city := range addresses.Addresses.City {
+		prefecture := cityPrefecture[i]
+		if prefecture.Kanji() == nearest.Kanji() && city.Kanji() == nearest.office {
+			return &Address{Prefecture: prefecture, City: city}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package gimei_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestLocationDistance(t *testing.T) {
+	tokyo := gimei.FindPrefectureByKanji("東京都").Location
+	osaka := gimei.FindPrefectureByKanji("大阪府").Location
+	// about 400km
+	if d := tokyo.Distance(osaka); d < 380 || d > 420 {
+		t.Errorf("distance between 東京都 and 大阪府 == %v", d)
+	}
+	if d := tokyo.Distance(tokyo); d != 0 {
+		t.Errorf("distance to itself == %v, want 0", d)
+	}
+}
+
+func TestAddressLocation(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		addr := gimei.NewAddress()
+		l := addr.Location()
+		if l.Latitude < 20 || l.Latitude > 46 || l.Longitude < 122 || l.Longitude > 154 {
+			t.Fatalf("Location() of %s == %v is not in Japan", addr, l)
+		}
+		// the destination point is exact on the sphere, so allow only rounding error
+		if d := addr.JitteredLocation(10).Distance(l); d > 10+1e-9 || math.IsNaN(d) {
+			t.Fatalf("JitteredLocation(10) of %s is %vkm away", addr, d)
+		}
+	}
+}
+
+func TestFindNearestCity(t *testing.T) {
+	tests := []struct {
+		location gimei.Location
+		want     string
+	}{
+		{gimei.Location{Latitude: 35.6812, Longitude: 139.7671}, "東京都新宿区"},    // 東京駅
+		{gimei.Location{Latitude: 34.7025, Longitude: 135.4959}, "大阪府大阪市中央区"}, // 大阪駅
+		{gimei.Location{Latitude: 24.3448, Longitude: 124.1572}, "沖縄県那覇市"},    // 石垣島
+	}
+	for _, test := range tests {
+		addr := gimei.FindNearestCity(test.location)
+		if addr == nil || addr.Kanji() != test.want {
+			t.Errorf("FindNearestCity(%v) == %v, want %s", test.location, addr, test.want)
+		}
+	}
+}
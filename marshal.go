@@ -0,0 +1,533 @@
+package gimei
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// itemData store Item with named fields for JSON and YAML.
+type itemData struct {
+	Kanji    string `json:"kanji" yaml:"kanji"`
+	Hiragana string `json:"hiragana,omitempty" yaml:"hiragana,omitempty"`
+	Katakana string `json:"katakana,omitempty" yaml:"katakana,omitempty"`
+	Romaji   string `json:"romaji,omitempty" yaml:"romaji,omitempty"`
+}
+
+func (d itemData) item() Item {
+	i := Item{d.Kanji, d.Hiragana, d.Katakana, d.Romaji}
+	for len(i) > 0 && i[len(i)-1] == "" {
+		i = i[:len(i)-1]
+	}
+	return i
+}
+
+func (i Item) data() itemData {
+	return itemData{i.Kanji(), i.Hiragana(), i.Katakana(), i.raw(3)}
+}
+
+// raw return n-th string of Item as it is.
+func (i Item) raw(n int) string {
+	if len(i) <= n {
+		return ""
+	}
+	return i[n]
+}
+
+// MarshalJSON implement json.Marshaler.
+func (i Item) MarshalJSON() ([]byte, error) {
+	if i == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(i.data())
+}
+
+// UnmarshalJSON implement json.Unmarshaler. Both of object with named fields
+// and array of strings are accepted.
+func (i *Item) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(b, &list); err == nil {
+		*i = list
+		return nil
+	}
+	var d itemData
+	if err := json.Unmarshal(b, &d); err != nil {
+		return err
+	}
+	*i = d.item()
+	return nil
+}
+
+// MarshalYAML implement yaml.Marshaler.
+func (i Item) MarshalYAML() (interface{}, error) {
+	if i == nil {
+		return nil, nil
+	}
+	return i.data(), nil
+}
+
+// UnmarshalYAML implement yaml.Unmarshaler. Both of mapping with named fields
+// and sequence of strings are accepted.
+func (i *Item) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []string
+	if err := unmarshal(&list); err == nil {
+		*i = list
+		return nil
+	}
+	var d itemData
+	if err := unmarshal(&d); err != nil {
+		return err
+	}
+	*i = d.item()
+	return nil
+}
+
+// MarshalText implement encoding.TextMarshaler.
+func (i Item) MarshalText() ([]byte, error) {
+	return []byte(i.Kanji()), nil
+}
+
+// UnmarshalText implement encoding.TextUnmarshaler. The kanji is looked up in
+// last names, first names, prefectures, cities and towns, so the reading may
+// be another one if the kanji has several readings. Item has only kanji if not
+// found.
+func (i *Item) UnmarshalText(b []byte) error {
+	*i = findItemByKanji(string(b))
+	return nil
+}
+
+func findItemByKanji(kanji string) Item {
+	onceName.Do(loadNames)
+	onceAddress.Do(loadAddresses)
+	for _, index := range []map[string]Item{
+		lastNameIndex[0],
+		maleFirstNameIndex[0],
+		femaleFirstNameIndex[0],
+		cityIndex[0],
+		townIndex[0],
+	} {
+		if item, ok := index[kanji]; ok {
+			return item
+		}
+	}
+	if p := FindPrefectureByKanji(kanji); p != nil {
+		return p.Item
+	}
+	return Item{kanji}
+}
+
+// MarshalText implement encoding.TextMarshaler. Sex is English label of
+// ISO/IEC 5218 such as "female".
+func (s Sex) MarshalText() ([]byte, error) {
+	return []byte(s.English()), nil
+}
+
+// UnmarshalText implement encoding.TextUnmarshaler. See ParseSex.
+func (s *Sex) UnmarshalText(b []byte) error {
+	sex, ok := ParseSex(string(b))
+	if !ok {
+		return fmt.Errorf("gimei: unknown sex: %q", b)
+	}
+	*s = sex
+	return nil
+}
+
+// UnmarshalJSON implement json.Unmarshaler. Both of string accepted by
+// ParseSex and number of ISO/IEC 5218 code are accepted.
+func (s *Sex) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(b, &text); err != nil {
+		var code json.Number
+		if err := json.Unmarshal(b, &code); err != nil {
+			return fmt.Errorf("gimei: invalid sex: %s", b)
+		}
+		text = code.String()
+	}
+	return s.UnmarshalText([]byte(text))
+}
+
+// nameData store Name with named fields for JSON and YAML.
+type nameData struct {
+	First   Item         `json:"first" yaml:"first"`
+	Last    Item         `json:"last" yaml:"last"`
+	Sex     Sex          `json:"sex" yaml:"sex"`
+	History []NameChange `json:"history,omitempty" yaml:"history,omitempty"`
+}
+
+// MarshalJSON implement json.Marshaler.
+func (n Name) MarshalJSON() ([]byte, error) {
+	return json.Marshal(nameData(n))
+}
+
+// UnmarshalJSON implement json.Unmarshaler.
+func (n *Name) UnmarshalJSON(b []byte) error {
+	var d nameData
+	if err := json.Unmarshal(b, &d); err != nil {
+		return err
+	}
+	*n = Name(d)
+	return nil
+}
+
+// MarshalYAML implement yaml.Marshaler.
+func (n Name) MarshalYAML() (interface{}, error) {
+	return nameData(n), nil
+}
+
+// UnmarshalYAML implement yaml.Unmarshaler.
+func (n *Name) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var d nameData
+	if err := unmarshal(&d); err != nil {
+		return err
+	}
+	*n = Name(d)
+	return nil
+}
+
+// MarshalText implement encoding.TextMarshaler.
+func (n Name) MarshalText() ([]byte, error) {
+	return []byte(n.Kanji()), nil
+}
+
+// UnmarshalText implement encoding.TextUnmarshaler. The name is looked up by
+// FindNameByKanji, so it is lossy: the reading of kanji that has several
+// readings may be another one, Sex is guessed from the first name and History
+// is lost. Use JSON or YAML to restore Name as it is.
+func (n *Name) UnmarshalText(b []byte) error {
+	found := FindNameByKanji(string(b))
+	if found == nil {
+		return fmt.Errorf("gimei: unknown name: %q", b)
+	}
+	*n = *found
+	return nil
+}
+
+// addressData store Address with named fields for JSON and YAML.
+type addressData struct {
+	Prefecture Item `json:"prefecture" yaml:"prefecture"`
+	City       Item `json:"city" yaml:"city"`
+	Town       Item `json:"town" yaml:"town"`
+}
+
+// MarshalJSON implement json.Marshaler.
+func (a Address) MarshalJSON() ([]byte, error) {
+	return json.Marshal(addressData(a))
+}
+
+// UnmarshalJSON implement json.Unmarshaler.
+func (a *Address) UnmarshalJSON(b []byte) error {
+	var d addressData
+	if err := json.Unmarshal(b, &d); err != nil {
+		return err
+	}
+	*a = Address(d)
+	return nil
+}
+
+// MarshalYAML implement yaml.Marshaler.
+func (a Address) MarshalYAML() (interface{}, error) {
+	return addressData(a), nil
+}
+
+// UnmarshalYAML implement yaml.Unmarshaler.
+func (a *Address) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var d addressData
+	if err := unmarshal(&d); err != nil {
+		return err
+	}
+	*a = Address(d)
+	return nil
+}
+
+// MarshalText implement encoding.TextMarshaler.
+func (a Address) MarshalText() ([]byte, error) {
+	return []byte(a.Kanji()), nil
+}
+
+// UnmarshalText implement encoding.TextUnmarshaler. The address is looked up
+// by FindAddressByKanji.
+func (a *Address) UnmarshalText(b []byte) error {
+	found := FindAddressByKanji(string(b))
+	if found == nil {
+		return fmt.Errorf("gimei: unknown address: %q", b)
+	}
+	*a = *found
+	return nil
+}
+
+// postalCodeData store PostalCode with named fields for JSON and YAML.
+type postalCodeData struct {
+	Code string `json:"code" yaml:"code"`
+}
+
+// MarshalJSON implement json.Marshaler.
+func (p PostalCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(postalCodeData{p.Code.Kanji()})
+}
+
+// UnmarshalJSON implement json.Unmarshaler.
+func (p *PostalCode) UnmarshalJSON(b []byte) error {
+	var d postalCodeData
+	if err := json.Unmarshal(b, &d); err != nil {
+		return err
+	}
+	return p.UnmarshalText([]byte(d.Code))
+}
+
+// MarshalYAML implement yaml.Marshaler.
+func (p PostalCode) MarshalYAML() (interface{}, error) {
+	return postalCodeData{p.Code.Kanji()}, nil
+}
+
+// UnmarshalYAML implement yaml.Unmarshaler.
+func (p *PostalCode) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var d postalCodeData
+	if err := unmarshal(&d); err != nil {
+		return err
+	}
+	return p.UnmarshalText([]byte(d.Code))
+}
+
+// MarshalText implement encoding.TextMarshaler.
+func (p PostalCode) MarshalText() ([]byte, error) {
+	return []byte(p.Code.Kanji()), nil
+}
+
+// UnmarshalText implement encoding.TextUnmarshaler. The code is looked up in
+// postal codes.
+func (p *PostalCode) UnmarshalText(b []byte) error {
+	oncePostal.Do(loadPostalCodes)
+	code := string(b)
+	list := postalCodes.PostalCodes
+	n := sort.Search(len(list), func(i int) bool { return list[i].Kanji() >= code })
+	if n == len(list) || list[n].Kanji() != code {
+		return fmt.Errorf("gimei: unknown postal code: %q", b)
+	}
+	p.Code = list[n]
+	return nil
+}
+
+// MarshalText implement encoding.TextMarshaler. Origin is key such as
+// "western".
+func (o Origin) MarshalText() ([]byte, error) {
+	for key, origin := range originKeys {
+		if origin == o {
+			return []byte(key), nil
+		}
+	}
+	return nil, fmt.Errorf("gimei: unknown origin: %d", int(o))
+}
+
+// UnmarshalText implement encoding.TextUnmarshaler.
+func (o *Origin) UnmarshalText(b []byte) error {
+	origin, ok := originKeys[string(b)]
+	if !ok {
+		return fmt.Errorf("gimei: unknown origin: %q", b)
+	}
+	*o = origin
+	return nil
+}
+
+// foreignNameFields store ForeignName with named fields for JSON and YAML.
+type foreignNameFields struct {
+	First       Item         `json:"first" yaml:"first"`
+	Last        Item         `json:"last" yaml:"last"`
+	Sex         Sex          `json:"sex" yaml:"sex"`
+	History     []NameChange `json:"history,omitempty" yaml:"history,omitempty"`
+	Middle      Item         `json:"middle,omitempty" yaml:"middle,omitempty"`
+	Native      *Name        `json:"native,omitempty" yaml:"native,omitempty"`
+	Alias       *Name        `json:"alias,omitempty" yaml:"alias,omitempty"`
+	Origin      Origin       `json:"origin" yaml:"origin"`
+	Nationality string       `json:"nationality" yaml:"nationality"`
+}
+
+func (f ForeignName) fields() foreignNameFields {
+	return foreignNameFields{
+		First:       f.First,
+		Last:        f.Last,
+		Sex:         f.Sex,
+		History:     f.History,
+		Middle:      f.Middle,
+		Native:      f.Native,
+		Alias:       f.Alias,
+		Origin:      f.Origin,
+		Nationality: f.Nationality,
+	}
+}
+
+func (f *ForeignName) setFields(d foreignNameFields) {
+	*f = ForeignName{
+		Name:        Name{First: d.First, Last: d.Last, Sex: d.Sex, History: d.History},
+		Middle:      d.Middle,
+		Native:      d.Native,
+		Alias:       d.Alias,
+		Origin:      d.Origin,
+		Nationality: d.Nationality,
+	}
+}
+
+// MarshalJSON implement json.Marshaler.
+func (f ForeignName) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.fields())
+}
+
+// UnmarshalJSON implement json.Unmarshaler.
+func (f *ForeignName) UnmarshalJSON(b []byte) error {
+	var d foreignNameFields
+	if err := json.Unmarshal(b, &d); err != nil {
+		return err
+	}
+	f.setFields(d)
+	return nil
+}
+
+// MarshalYAML implement yaml.Marshaler.
+func (f ForeignName) MarshalYAML() (interface{}, error) {
+	return f.fields(), nil
+}
+
+// UnmarshalYAML implement yaml.Unmarshaler.
+func (f *ForeignName) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var d foreignNameFields
+	if err := unmarshal(&d); err != nil {
+		return err
+	}
+	f.setFields(d)
+	return nil
+}
+
+// MarshalText implement encoding.TextMarshaler.
+func (f ForeignName) MarshalText() ([]byte, error) {
+	return []byte(f.Kanji()), nil
+}
+
+// UnmarshalText implement encoding.TextUnmarshaler. The name is looked up in
+// names of foreign residents. Alias and Nationality are not restored.
+func (f *ForeignName) UnmarshalText(b []byte) error {
+	found := findForeignNameByKanji(string(b))
+	if found == nil {
+		return fmt.Errorf("gimei: unknown foreign name: %q", b)
+	}
+	*f = *found
+	return nil
+}
+
+// MarshalText implement encoding.TextMarshaler. Region is label such as
+// "中国".
+func (r Region) MarshalText() ([]byte, error) {
+	if r < Hokkaido || r > Kyushu {
+		return nil, fmt.Errorf("gimei: unknown region: %d", int(r))
+	}
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implement encoding.TextUnmarshaler.
+func (r *Region) UnmarshalText(b []byte) error {
+	for region := Hokkaido; region <= Kyushu; region++ {
+		if region.String() == string(b) {
+			*r = region
+			return nil
+		}
+	}
+	return fmt.Errorf("gimei: unknown region: %q", b)
+}
+
+// prefectureFields store Prefecture with named fields for JSON and YAML.
+type prefectureFields struct {
+	Name       Item     `json:"name" yaml:"name"`
+	Code       string   `json:"code" yaml:"code"`
+	English    string   `json:"english" yaml:"english"`
+	Population int      `json:"population" yaml:"population"`
+	Region     Region   `json:"region" yaml:"region"`
+	Area       string   `json:"area" yaml:"area"`
+	Capital    Item     `json:"capital" yaml:"capital"`
+	AreaCode   string   `json:"area_code" yaml:"area_code"`
+	Location   Location `json:"location" yaml:"location"`
+}
+
+func (p Prefecture) fields() prefectureFields {
+	return prefectureFields{
+		Name:       p.Item,
+		Code:       p.Code,
+		English:    p.English,
+		Population: p.Population,
+		Region:     p.Region,
+		Area:       p.Area,
+		Capital:    p.Capital,
+		AreaCode:   p.AreaCode,
+		Location:   p.Location,
+	}
+}
+
+func (p *Prefecture) setFields(d prefectureFields) {
+	*p = Prefecture{
+		Item:       d.Name,
+		Code:       d.Code,
+		English:    d.English,
+		Population: d.Population,
+		Region:     d.Region,
+		Area:       d.Area,
+		Capital:    d.Capital,
+		AreaCode:   d.AreaCode,
+		Location:   d.Location,
+	}
+	if found := FindPrefectureByCode(d.Code); found != nil {
+		p.office = found.office
+	}
+}
+
+// MarshalJSON implement json.Marshaler. Without it, MarshalJSON of Item is
+// promoted and the metadata is lost.
+func (p Prefecture) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.fields())
+}
+
+// UnmarshalJSON implement json.Unmarshaler.
+func (p *Prefecture) UnmarshalJSON(b []byte) error {
+	var d prefectureFields
+	if err := json.Unmarshal(b, &d); err != nil {
+		return err
+	}
+	p.setFields(d)
+	return nil
+}
+
+// MarshalYAML implement yaml.Marshaler.
+func (p Prefecture) MarshalYAML() (interface{}, error) {
+	return p.fields(), nil
+}
+
+// UnmarshalYAML implement yaml.Unmarshaler.
+func (p *Prefecture) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var d prefectureFields
+	if err := unmarshal(&d); err != nil {
+		return err
+	}
+	p.setFields(d)
+	return nil
+}
+
+// MarshalText implement encoding.TextMarshaler.
+func (p Prefecture) MarshalText() ([]byte, error) {
+	return []byte(p.Kanji()), nil
+}
+
+// UnmarshalText implement encoding.TextUnmarshaler. The prefecture is looked
+// up by kanji or code, so all the metadata is restored.
+func (p *Prefecture) UnmarshalText(b []byte) error {
+	found := FindPrefectureByKanji(string(b))
+	if found == nil {
+		found = FindPrefectureByCode(string(b))
+	}
+	if found == nil {
+		return fmt.Errorf("gimei: unknown prefecture: %q", b)
+	}
+	*p = *found
+	return nil
+}
@@ -0,0 +1,282 @@
+package gimei_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-gimei"
+	"gopkg.in/yaml.v2"
+)
+
+func TestItemJSON(t *testing.T) {
+	item := gimei.Item{"小林", "こばやし", "コバヤシ", "kobayashi"}
+	b, err := json.Marshal(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"kanji":"小林","hiragana":"こばやし","katakana":"コバヤシ","romaji":"kobayashi"}`
+	if string(b) != want {
+		t.Fatalf("want %s but %s", want, b)
+	}
+	for _, s := range []string{want, `["小林","こばやし","コバヤシ","kobayashi"]`} {
+		var got gimei.Item
+		if err := json.Unmarshal([]byte(s), &got); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, item) {
+			t.Fatalf("want %v but %v", item, got)
+		}
+	}
+
+	b, err = json.Marshal(gimei.Item{"岡山県", "おかやまけん", "オカヤマケン"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"kanji":"岡山県","hiragana":"おかやまけん","katakana":"オカヤマケン"}`; string(b) != want {
+		t.Fatalf("want %s but %s", want, b)
+	}
+}
+
+func TestNameJSON(t *testing.T) {
+	name := gimei.NewNameWithMaidenName()
+	b, err := json.Marshal(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"sex":"`+name.Sex.English()+`"`) || !strings.Contains(string(b), `"history":[`) {
+		t.Fatalf("unexpected JSON: %s", b)
+	}
+	var got gimei.Name
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, name) {
+		t.Fatalf("want %#v but %#v", name, &got)
+	}
+
+	// embedded as value
+	v := struct {
+		Name gimei.Name `json:"name"`
+	}{*gimei.NewMale()}
+	b, err = json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(b), `{"name":{"first":{"kanji":`) || strings.Contains(string(b), "history") {
+		t.Fatalf("unexpected JSON: %s", b)
+	}
+
+	// Sex and History that text can not restore are kept
+	neutral := &gimei.Name{
+		Last:    name.Last,
+		First:   gimei.Item{"薫", "かおり", "カオリ", "kaori"},
+		Sex:     gimei.Other,
+		History: []gimei.NameChange{{Last: gimei.Item{"鈴木", "すずき", "スズキ", "suzuki"}, Date: time.Date(2010, time.April, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+	b, err = json.Marshal(neutral)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = gimei.Name{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, neutral) {
+		t.Fatalf("want %#v but %#v", neutral, &got)
+	}
+
+	// legacy format which has array of strings and ISO/IEC 5218 code
+	legacy := `{"First":["花子","はなこ","ハナコ","hanako"],"Last":["山田","やまだ","ヤマダ","yamada"],"Sex":2}`
+	got = gimei.Name{}
+	if err := json.Unmarshal([]byte(legacy), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := gimei.Name{
+		First: gimei.Item{"花子", "はなこ", "ハナコ", "hanako"},
+		Last:  gimei.Item{"山田", "やまだ", "ヤマダ", "yamada"},
+		Sex:   gimei.Female,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %#v but %#v", want, got)
+	}
+
+	for _, s := range []string{`{"sex":"unknown"}`, `{"sex":5}`, `{"sex":1.5}`, `{"sex":true}`} {
+		if err := json.Unmarshal([]byte(s), &got); err == nil {
+			t.Fatalf("%s should be error", s)
+		}
+	}
+}
+
+func TestAddressJSON(t *testing.T) {
+	address := gimei.NewAddress()
+	b, err := json.Marshal(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(b), `{"prefecture":{"kanji":"`+address.Prefecture.Kanji()+`"`) {
+		t.Fatalf("unexpected JSON: %s", b)
+	}
+	var got gimei.Address
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, address) {
+		t.Fatalf("want %v but %v", address, &got)
+	}
+}
+
+func TestPostalCodeJSON(t *testing.T) {
+	code := gimei.NewPostalCode()
+	b, err := json.Marshal(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"code":"` + code.Kanji() + `"}`; string(b) != want {
+		t.Fatalf("want %s but %s", want, b)
+	}
+	var got gimei.PostalCode
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, code) {
+		t.Fatalf("want %v but %v", code, &got)
+	}
+	if err := json.Unmarshal([]byte(`{"code":"000-0000"}`), &got); err == nil {
+		t.Fatal("unknown postal code should be error")
+	}
+}
+
+func TestYAML(t *testing.T) {
+	v := struct {
+		Name    gimei.Name       `yaml:"name"`
+		Address gimei.Address    `yaml:"address"`
+		Postal  gimei.PostalCode `yaml:"postal"`
+	}{*gimei.NewName(), *gimei.NewAddress(), *gimei.NewPostalCode()}
+	v.Name.History = []gimei.NameChange{{Last: gimei.NewName().Last, Date: time.Date(2015, time.June, 1, 0, 0, 0, 0, time.UTC)}}
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"kanji: " + v.Name.Last.Kanji(), "sex: " + v.Name.Sex.English(), "prefecture:", "code: " + v.Postal.Code.Kanji()} {
+		if !strings.Contains(string(b), s) {
+			t.Fatalf("%q not found in YAML:\n%s", s, b)
+		}
+	}
+	got := v
+	got.Name, got.Address, got.Postal = gimei.Name{}, gimei.Address{}, gimei.PostalCode{}
+	if err := yaml.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Fatalf("want %#v but %#v", v, got)
+	}
+}
+
+func TestText(t *testing.T) {
+	name := gimei.NewName()
+	address := gimei.NewAddress()
+	var v struct {
+		Name    gimei.Name
+		Address gimei.Address
+		Item    gimei.Item
+		Sex     gimei.Sex
+	}
+	for _, test := range []struct {
+		text string
+		dst  interface {
+			UnmarshalText([]byte) error
+		}
+	}{
+		{name.Kanji(), &v.Name},
+		{address.Kanji(), &v.Address},
+		{name.Last.Kanji(), &v.Item},
+		{"2", &v.Sex},
+	} {
+		if err := test.dst.UnmarshalText([]byte(test.text)); err != nil {
+			t.Fatalf("UnmarshalText(%q): %v", test.text, err)
+		}
+	}
+	// text is lossy: reading may differ if kanji has several readings
+	if v.Name.Kanji() != name.Kanji() || v.Address.Kanji() != address.Kanji() || v.Item.Kanji() != name.Last.Kanji() || v.Item.Hiragana() == "" || v.Sex != gimei.Female {
+		t.Fatalf("unexpected values: %#v", v)
+	}
+	if err := v.Name.UnmarshalText([]byte("偽 名")); err == nil {
+		t.Fatal("unknown name should be error")
+	}
+	if b, _ := name.MarshalText(); string(b) != name.Kanji() {
+		t.Fatalf("want %q but %q", name.Kanji(), b)
+	}
+}
+
+func TestForeignNameJSON(t *testing.T) {
+	for _, origin := range []gimei.Origin{gimei.Western, gimei.Chinese, gimei.Korean} {
+		f := gimei.NewForeignNameOf(origin)
+		b, err := json.Marshal(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got gimei.ForeignName
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(&got, f) {
+			t.Fatalf("want %#v but %#v", f, &got)
+		}
+
+		got = gimei.ForeignName{}
+		if err := got.UnmarshalText([]byte(f.Kanji())); err != nil {
+			t.Fatal(err)
+		}
+		if got.Kanji() != f.Kanji() || got.Alphabet() != f.Alphabet() {
+			t.Fatalf("want %s but %s", f.Alphabet(), got.Alphabet())
+		}
+	}
+}
+
+func TestPrefectureJSON(t *testing.T) {
+	p := gimei.FindPrefectureByCode("33")
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{`"code":"33"`, `"english":"Okayama Prefecture"`, `"region":"中国"`, `"population":`, `"capital":{"kanji":"岡山市"`, `"location":{"latitude":`} {
+		if !strings.Contains(string(b), s) {
+			t.Fatalf("%s not found in %s", s, b)
+		}
+	}
+	var got gimei.Prefecture
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, p) {
+		t.Fatalf("want %#v but %#v", p, &got)
+	}
+
+	b, err = yaml.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = gimei.Prefecture{}
+	if err := yaml.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, p) {
+		t.Fatalf("want %#v but %#v", p, &got)
+	}
+
+	for _, text := range []string{"岡山県", "33"} {
+		got = gimei.Prefecture{}
+		if err := got.UnmarshalText([]byte(text)); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(&got, p) {
+			t.Fatalf("UnmarshalText(%q): want %#v but %#v", text, p, &got)
+		}
+	}
+	if err := got.UnmarshalText([]byte("偽県")); err == nil {
+		t.Fatal("unknown prefecture should be error")
+	}
+}
@@ -0,0 +1,135 @@
+package gimei
+
+import (
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	municipalityCodes    municipalityCode
+	codeByCity           map[string]string
+	cityByCode           map[string]*Address
+	onceMunicipalityCode sync.Once
+)
+
+// municipalityCode store data sturecture just same as municipalitycodes.yml.
+type municipalityCode struct {
+	MunicipalityCodes [][]string `yaml:"municipality_codes"`
+}
+
+func loadMunicipalityCodes() {
+	onceAddress.Do(loadAddresses)
+	if b, err := assets.ReadFile("data/municipalitycodes.yml"); err == nil {
+		if err = yaml.Unmarshal(b, &municipalityCodes); err == nil {
+			buildMunicipalityCodeIndex()
+			return
+		}
+	}
+	panic("failed to load municipality codes data")
+}
+
+func buildMunicipalityCodeIndex() {
+	// key is JIS X 0401 code of prefecture and kanji of city.
+	codes := make(map[string]string, len(municipalityCodes.MunicipalityCodes))
+	for _, entry := range municipalityCodes.MunicipalityCodes {
+		codes[entry[0][:2]+entry[1]] = entry[0]
+	}
+	codeByCity = make(map[string]string, len(codes))
+	cityByCode = make(map[string]*Address, len(codes))
+	for i, city := range addresses.Addresses.City {
+		prefecture := cityPrefecture[i]
+		code, ok := codes[prefectureCodeOf(prefecture)+city.Kanji()]
+		if !ok {
+			panic("missing city in municipality codes data: " + prefecture.Kanji() + city.Kanji())
+		}
+		codeByCity[prefecture.Kanji()+city.Kanji()] = code
+		if _, ok := codeByCity[city.Kanji()]; !ok {
+			// for city that is not in the prefecture of Address
+			codeByCity[city.Kanji()] = code
+		}
+		cityByCode[code[:5]] = &Address{Prefecture: prefecture, City: city}
+	}
+}
+
+func prefectureCodeOf(prefecture Item) string {
+	if p := FindPrefectureByKanji(prefecture.Kanji()); p != nil {
+		return p.Code
+	}
+	return ""
+}
+
+func checkDigit(code string) byte {
+	sum := 0
+	for i, weight := range []int{6, 5, 4, 3, 2} {
+		sum += int(code[i]-'0') * weight
+	}
+	return byte('0' + (11-sum%11)%10)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || '9' < s[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// AddCheckDigit return 6 digits 全国地方公共団体コード which the check digit is
+// appended to 5 digits code. It return empty string if code is not 5 digits.
+func AddCheckDigit(code string) string {
+	if len(code) != 5 || !isDigits(code) {
+		return ""
+	}
+	return code + string(checkDigit(code))
+}
+
+// ValidMunicipalityCode return true if code is 6 digits 全国地方公共団体コード
+// with the correct check digit.
+func ValidMunicipalityCode(code string) bool {
+	if len(code) != 6 || !isDigits(code) {
+		return false
+	}
+	return checkDigit(code) == code[5]
+}
+
+// MunicipalityCode return 6 digits 全国地方公共団体コード of the prefecture.
+func (p *Prefecture) MunicipalityCode() string {
+	return AddCheckDigit(p.Code + "000")
+}
+
+// MunicipalityCode return 6 digits 全国地方公共団体コード of city of Address.
+// Every city generated by gimei has the code. NewAddress may pick the city out
+// of the prefecture, and then the code of the city is returned, so the first 2
+// digits may differ from PrefectureCode. It return empty string if the city is
+// not in the embedded data.
+func (a *Address) MunicipalityCode() string {
+	onceMunicipalityCode.Do(loadMunicipalityCodes)
+	if code, ok := codeByCity[a.Prefecture.Kanji()+a.City.Kanji()]; ok {
+		return code
+	}
+	return codeByCity[a.City.Kanji()]
+}
+
+// HasMunicipalityCode return true if 全国地方公共団体コード of city of Address
+// is known. It is always true for addresses generated by gimei.
+func (a *Address) HasMunicipalityCode() bool {
+	return a.MunicipalityCode() != ""
+}
+
+// FindCityByCode find Address by 全国地方公共団体コード. Both 5 digits and 6
+// digits with check digit are accepted. Town of the Address is empty.
+func FindCityByCode(code string) *Address {
+	onceMunicipalityCode.Do(loadMunicipalityCodes)
+	if len(code) == 6 {
+		if !ValidMunicipalityCode(code) {
+			return nil
+		}
+		code = code[:5]
+	}
+	if a, ok := cityByCode[code]; ok {
+		return &Address{Prefecture: a.Prefecture, City: a.City}
+	}
+	return nil
+}
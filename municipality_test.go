@@ -0,0 +1,90 @@
+package gimei_test
+
+import (
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestValidMunicipalityCode(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{"010006", true},  // 北海道
+		{"131016", true},  // 千代田区
+		{"472018", true},  // 那覇市
+		{"131017", false}, // wrong check digit
+		{"13101", false},
+		{"13101a", false},
+	}
+	for _, test := range tests {
+		if got := gimei.ValidMunicipalityCode(test.code); got != test.want {
+			t.Errorf("ValidMunicipalityCode(%q) == %v, want %v", test.code, got, test.want)
+		}
+	}
+	if got := gimei.AddCheckDigit("13101"); got != "131016" {
+		t.Errorf("AddCheckDigit(13101) == %q, want %q", got, "131016")
+	}
+	if got := gimei.FindPrefectureByCode("33").MunicipalityCode(); got != "330001" {
+		t.Errorf("MunicipalityCode() of 岡山県 == %q, want %q", got, "330001")
+	}
+}
+
+func TestFindCityByCode(t *testing.T) {
+	for _, code := range []string{"13206", "132063"} {
+		addr := gimei.FindCityByCode(code)
+		if addr == nil {
+			t.Fatalf("FindCityByCode(%q) should not return nil", code)
+		}
+		if addr.Kanji() != "東京都府中市" {
+			t.Errorf("FindCityByCode(%q) == %s, want 東京都府中市", code, addr)
+		}
+		if addr.MunicipalityCode() != "132063" {
+			t.Errorf("MunicipalityCode() of %s == %q, want %q", addr, addr.MunicipalityCode(), "132063")
+		}
+	}
+	if addr := gimei.FindCityByCode("132064"); addr != nil {
+		t.Errorf("FindCityByCode(132064) should return nil: %s", addr)
+	}
+
+	for i := 0; i < 100; i++ {
+		addr := gimei.NewAddress()
+		code := addr.MunicipalityCode()
+		if !gimei.ValidMunicipalityCode(code) {
+			t.Fatalf("MunicipalityCode() of %s == %q is invalid", addr, code)
+		}
+		if found := gimei.FindCityByCode(code); found.City.Kanji() != addr.City.Kanji() {
+			t.Fatalf("FindCityByCode(%q) == %s, want %s", code, found, addr)
+		}
+	}
+}
+
+func TestMunicipalityCodeOfAllCities(t *testing.T) {
+	// the filter is called for every city
+	var cities []*gimei.Address
+	gimei.NewAddressWith(func(a *gimei.Address) bool {
+		cities = append(cities, a)
+		return false
+	})
+	if len(cities) != 1895 {
+		t.Fatalf("want 1895 cities but %d", len(cities))
+	}
+	seen := map[string]string{}
+	for _, addr := range cities {
+		code := addr.MunicipalityCode()
+		if !gimei.ValidMunicipalityCode(code) {
+			t.Fatalf("MunicipalityCode() of %s == %q is invalid", addr, code)
+		}
+		if code[:2] != addr.PrefectureCode() {
+			t.Fatalf("MunicipalityCode() of %s == %q, want prefecture %s", addr, code, addr.PrefectureCode())
+		}
+		if other, ok := seen[code]; ok {
+			t.Fatalf("MunicipalityCode() of %s == %q is same as %s", addr, code, other)
+		}
+		seen[code] = addr.Kanji()
+		if found := gimei.FindCityByCode(code); found == nil || found.Kanji() != addr.Kanji() {
+			t.Fatalf("FindCityByCode(%q) == %v, want %s", code, found, addr)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package gimei
+
+import (
+	"math/rand"
+	"sync/atomic"
+)
+
+// settings store settings that change how data is picked.
+type settings struct {
+	populationWeighted bool
+	sexRatio           [3]int // ratio of male, female and other that NewName picks
+}
+
+var currentSettings atomic.Value
+
+func init() {
+	currentSettings.Store(settings{sexRatio: [3]int{1, 1, 0}})
+}
+
+// loadSettings return current settings. It can be called without mu.
+func loadSettings() settings {
+	return currentSettings.Load().(settings)
+}
+
+// updateSettings update current settings by f.
+func updateSettings(f func(s *settings)) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	s := loadSettings()
+	f(&s)
+	currentSettings.Store(s)
+}
+
+// picker pick data with the random source by the settings. The random source
+// must not be used by other goroutines at the same time.
+type picker struct {
+	rand *rand.Rand
+	settings
+}
+
+func newPicker(rnd *rand.Rand) picker {
+	return picker{rand: rnd, settings: loadSettings()}
+}
+
+// global return picker with the global random source. mu must be locked.
+func global() picker {
+	return newPicker(r)
+}
+
+// name return new instance of person.
+func (p picker) name() *Name {
+	return p.nameOf(p.sex())
+}
+
+// nameOf return new instance of person of the sex.
+func (p picker) nameOf(sex Sex) *Name {
+	onceName.Do(loadNames)
+	return &Name{
+		First: p.givenName(sex),
+		Last:  names.LastName[p.rand.Intn(len(names.LastName))],
+		Sex:   sex,
+	}
+}
+
+// pet return new instance of person whose last name begins "inu" for dog or
+// "neko" for cat. Animal name is picked as first name if sex is Unspecified.
+func (p picker) pet(kind string, sex Sex) *Name {
+	onceName.Do(loadNames)
+	first := names.FirstName.Animal
+	switch sex {
+	case Male:
+		first = names.FirstName.Male
+	case Female:
+		first = names.FirstName.Female
+	}
+	last := names.LastNameDog
+	if kind == "cat" {
+		last = names.LastNameCat
+	}
+	return &Name{
+		First: first[p.rand.Intn(len(first))],
+		Last:  last[p.rand.Intn(len(last))],
+		Sex:   sex,
+	}
+}
+
+// sex return Sex by the ratio.
+func (p picker) sex() Sex {
+	n := p.rand.Intn(p.sexRatio[0] + p.sexRatio[1] + p.sexRatio[2])
+	if n < p.sexRatio[0] {
+		return Male
+	}
+	if n < p.sexRatio[0]+p.sexRatio[1] {
+		return Female
+	}
+	return Other
+}
+
+// givenName return first name for Sex. Gender-neutral name is picked if sex
+// is neither male nor female.
+func (p picker) givenName(sex Sex) Item {
+	switch sex {
+	case Male:
+		return names.FirstName.Male[p.rand.Intn(len(names.FirstName.Male))]
+	case Female:
+		return names.FirstName.Female[p.rand.Intn(len(names.FirstName.Female))]
+	}
+	return names.FirstName.Neutral[p.rand.Intn(len(names.FirstName.Neutral))]
+}
+
+// address return new instance of address. When population weighted mode is
+// enabled, city of the address is in the prefecture.
+func (p picker) address() *Address {
+	onceAddress.Do(loadAddresses)
+	if p.populationWeighted {
+		// keep city consistent with its prefecture as NewAddressWith does.
+		onceWeight.Do(loadWeights)
+		i := p.weighted(cityWeights)
+		return &Address{
+			Prefecture: cityPrefecture[i],
+			City:       addresses.Addresses.City[i],
+			Town:       p.town(),
+		}
+	}
+	return &Address{
+		Prefecture: p.prefecture(),
+		City:       p.city(),
+		Town:       p.town(),
+	}
+}
+
+// prefecture return new instance of prefecture.
+func (p picker) prefecture() Item {
+	onceAddress.Do(loadAddresses)
+	if p.populationWeighted {
+		onceWeight.Do(loadWeights)
+		return addresses.Addresses.Prefecture[p.weighted(prefectureWeights)]
+	}
+	return addresses.Addresses.Prefecture[p.rand.Intn(len(addresses.Addresses.Prefecture))]
+}
+
+// city return new instance of city.
+func (p picker) city() Item {
+	onceAddress.Do(loadAddresses)
+	if p.populationWeighted {
+		onceWeight.Do(loadWeights)
+		return addresses.Addresses.City[p.weighted(cityWeights)]
+	}
+	return addresses.Addresses.City[p.rand.Intn(len(addresses.Addresses.City))]
+}
+
+// town return new instance of town.
+func (p picker) town() Item {
+	onceAddress.Do(loadAddresses)
+	return addresses.Addresses.Town[p.rand.Intn(len(addresses.Addresses.Town))]
+}
+
+// postalCode return new instance of postal code.
+func (p picker) postalCode() *PostalCode {
+	oncePostal.Do(loadPostalCodes)
+	return &PostalCode{
+		Code: postalCodes.PostalCodes[p.rand.Intn(len(postalCodes.PostalCodes))],
+	}
+}
+
+// weighted return index of weights picked with probability proportional to
+// the weight.
+func (p picker) weighted(weights []int64) int {
+	var total int64
+	for _, w := range weights {
+		total += w
+	}
+	if total == 0 {
+		// nobody lives in the cities, e.g. 双葉町 of 2020 census
+		return p.rand.Intn(len(weights))
+	}
+	n := p.rand.Int63n(total)
+	for i, w := range weights {
+		if n < w {
+			return i
+		}
+		n -= w
+	}
+	return len(weights) - 1
+}
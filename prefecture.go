@@ -0,0 +1,159 @@
+package gimei
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	prefectureList    []*Prefecture
+	prefectureByCode  map[string]*Prefecture
+	prefectureByKanji map[string]*Prefecture
+	oncePrefecture    sync.Once
+)
+
+// Region is 地方区分 that prefecture belongs to.
+type Region int
+
+// list of region
+const (
+	Hokkaido Region = iota + 1 // 北海道
+	Tohoku                     // 東北
+	Kanto                      // 関東
+	Chubu                      // 中部
+	Kinki                      // 近畿
+	Chugoku                    // 中国
+	Shikoku                    // 四国
+	Kyushu                     // 九州・沖縄
+)
+
+// String implement Stringer.
+func (r Region) String() string {
+	switch r {
+	case Hokkaido:
+		return "北海道"
+	case Tohoku:
+		return "東北"
+	case Kanto:
+		return "関東"
+	case Chubu:
+		return "中部"
+	case Kinki:
+		return "近畿"
+	case Chugoku:
+		return "中国"
+	case Shikoku:
+		return "四国"
+	case Kyushu:
+		return "九州・沖縄"
+	}
+	return "？"
+}
+
+// prefectureData store data sturecture just same as prefectures.yml.
+type prefectureData struct {
+	Prefectures []struct {
+		Code       string    `yaml:"code"`
+		Name       Item      `yaml:"name"`
+		English    string    `yaml:"english"`
+		Population int       `yaml:"population"`
+		Region     string    `yaml:"region"`
+		Area       string    `yaml:"area"`
+		Capital    Item      `yaml:"capital"`
+		Office     string    `yaml:"office"`
+		AreaCode   string    `yaml:"area_code"`
+		Location   []float64 `yaml:"location"`
+	} `yaml:"prefectures"`
+}
+
+// Prefecture store metadata of prefecture.
+type Prefecture struct {
+	Item                // kanji/hiragana/katakana/romaji of prefecture
+	Code       string   // JIS X 0401 code such as "33"
+	English    string   // English name such as "Okayama Prefecture"
+	Population int      // population of 2020 census
+	Region     Region   // 8地方区分
+	Area       string   // 11地域区分 which splits 中部 into 甲信越/北陸/東海 and 九州・沖縄 into 九州/沖縄
+	Capital    Item     // 県庁所在地
+	AreaCode   string   // 市外局番 of prefectural office such as "086"
+	Location   Location // location of prefectural office
+
+	office string // city where prefectural office is in
+}
+
+func loadPrefectures() {
+	var data prefectureData
+	if b, err := assets.ReadFile("data/prefectures.yml"); err == nil {
+		if err = yaml.Unmarshal(b, &data); err == nil {
+			prefectureByCode = make(map[string]*Prefecture, len(data.Prefectures))
+			prefectureByKanji = make(map[string]*Prefecture, len(data.Prefectures))
+			for _, d := range data.Prefectures {
+				p := &Prefecture{
+					Item:       d.Name,
+					Code:       d.Code,
+					English:    d.English,
+					Population: d.Population,
+					Area:       d.Area,
+					Capital:    d.Capital,
+					Location: Location{
+						Latitude:  d.Location[0],
+						Longitude: d.Location[1],
+					},
+					AreaCode: d.AreaCode,
+					office:   d.Office,
+				}
+				for r := Hokkaido; r <= Kyushu; r++ {
+					if r.String() == d.Region {
+						p.Region = r
+					}
+				}
+				prefectureList = append(prefectureList, p)
+				prefectureByCode[p.Code] = p
+				prefectureByKanji[p.Kanji()] = p
+			}
+			return
+		}
+	}
+	panic("failed to load prefectures data")
+}
+
+// Prefectures return all prefectures in order of JIS X 0401 code.
+func Prefectures() []*Prefecture {
+	oncePrefecture.Do(loadPrefectures)
+	return append([]*Prefecture(nil), prefectureList...)
+}
+
+// FindPrefectureByCode find Prefecture by JIS X 0401 code. Both "01" and "1"
+// are accepted.
+func FindPrefectureByCode(code string) *Prefecture {
+	oncePrefecture.Do(loadPrefectures)
+	if n, err := strconv.Atoi(code); err == nil {
+		code = fmt.Sprintf("%02d", n)
+	}
+	return prefectureByCode[code]
+}
+
+// FindPrefectureByKanji find Prefecture by kanji.
+func FindPrefectureByKanji(kanji string) *Prefecture {
+	oncePrefecture.Do(loadPrefectures)
+	return prefectureByKanji[kanji]
+}
+
+// PrefectureCode return JIS X 0401 code of prefecture of Address.
+func (a *Address) PrefectureCode() string {
+	if p := FindPrefectureByKanji(a.Prefecture.Kanji()); p != nil {
+		return p.Code
+	}
+	return ""
+}
+
+// Region return 地方区分 of Address.
+func (a *Address) Region() Region {
+	if p := FindPrefectureByKanji(a.Prefecture.Kanji()); p != nil {
+		return p.Region
+	}
+	return 0
+}
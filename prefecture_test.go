@@ -0,0 +1,49 @@
+package gimei_test
+
+import (
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestPrefectures(t *testing.T) {
+	prefectures := gimei.Prefectures()
+	if len(prefectures) != 47 {
+		t.Fatalf("len(Prefectures()) == %d, want 47", len(prefectures))
+	}
+	for _, p := range prefectures {
+		if gimei.FindPrefectureByCode(p.Code) != p {
+			t.Errorf("FindPrefectureByCode(%q) should return %s", p.Code, p)
+		}
+		if gimei.FindPrefectureByKanji(p.Kanji()) != p {
+			t.Errorf("FindPrefectureByKanji(%q) should return %s", p.Kanji(), p)
+		}
+		if p.Region == 0 || p.Area == "" || p.English == "" || p.Romaji() == "" || p.Capital.Kanji() == "" {
+			t.Errorf("metadata of %s is missing: %+v", p, p)
+		}
+	}
+}
+
+func TestFindPrefectureByCode(t *testing.T) {
+	p := gimei.FindPrefectureByCode("33")
+	if p == nil {
+		t.Fatal("FindPrefectureByCode(33) should not return nil")
+	}
+	if p.Kanji() != "岡山県" || p.Romaji() != "Okayama" || p.English != "Okayama Prefecture" {
+		t.Errorf("FindPrefectureByCode(33) == %+v", p)
+	}
+	if p.Region != gimei.Chugoku || p.Capital.Kanji() != "岡山市" {
+		t.Errorf("FindPrefectureByCode(33) == %+v", p)
+	}
+	if gimei.FindPrefectureByCode("1") != gimei.FindPrefectureByKanji("北海道") {
+		t.Errorf("FindPrefectureByCode(1) should return 北海道")
+	}
+	if gimei.FindPrefectureByCode("48") != nil {
+		t.Errorf("FindPrefectureByCode(48) should return nil")
+	}
+
+	addr := gimei.NewAddressIn("岡山県")
+	if addr.PrefectureCode() != "33" {
+		t.Errorf("PrefectureCode() of %s == %q, want %q", addr, addr.PrefectureCode(), "33")
+	}
+}
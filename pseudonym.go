@@ -0,0 +1,55 @@
+package gimei
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/rand"
+)
+
+// Pseudonymizer map real data to fake data deterministically. The same input
+// is always mapped to the same fake data with the same key, so it can be used
+// to mask production data consistently across tables and runs. The mapping
+// depends on the embedded dictionaries and settings such as SetSexRatio and
+// SetPopulationWeighted.
+type Pseudonymizer struct {
+	key []byte
+}
+
+// NewPseudonymizer return new instance of Pseudonymizer keyed by the secret
+// key. Without the key, the real data can not be guessed from the fake data.
+func NewPseudonymizer(key []byte) *Pseudonymizer {
+	return &Pseudonymizer{key: append([]byte(nil), key...)}
+}
+
+// rand return random source seeded by HMAC-SHA256 of the input. kind
+// separate the sources of Name and Address for the same input.
+func (p *Pseudonymizer) rand(kind, input string) *rand.Rand {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte(kind))
+	mac.Write([]byte{0})
+	mac.Write([]byte(input))
+	// splitMix keep all 64 bits of the seed and is cheap to create.
+	s := splitMix(binary.BigEndian.Uint64(mac.Sum(nil)))
+	return rand.New(&s)
+}
+
+// Name return fake person for the input such as "山田 太郎" or ID of the
+// customer. If the input is a name found by FindNameByKanji, the fake person
+// has the same sex.
+func (p *Pseudonymizer) Name(input string) *Name {
+	sex := Unspecified
+	if found := FindNameByKanji(input); found != nil {
+		sex = found.Sex
+	}
+	if sex == Unspecified {
+		return newPicker(p.rand("name", input)).name()
+	}
+	return newPicker(p.rand("name", input)).nameOf(sex)
+}
+
+// Address return fake address for the input such as real address or ID of
+// the customer.
+func (p *Pseudonymizer) Address(input string) *Address {
+	return newPicker(p.rand("address", input)).address()
+}
@@ -0,0 +1,53 @@
+package gimei_test
+
+import (
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestPseudonymizer(t *testing.T) {
+	p := gimei.NewPseudonymizer([]byte("secret"))
+	for _, input := range []string{"山田 太郎", "customer-1", ""} {
+		if n1, n2 := p.Name(input), p.Name(input); n1.Kanji() != n2.Kanji() || n1.Sex != n2.Sex {
+			t.Fatalf("same input should be mapped to same name: %s != %s", n1, n2)
+		}
+		if a1, a2 := p.Address(input), p.Address(input); a1.Kanji() != a2.Kanji() {
+			t.Fatalf("same input should be mapped to same address: %s != %s", a1, a2)
+		}
+	}
+
+	q := gimei.NewPseudonymizer([]byte("another"))
+	same := 0
+	for i := 0; i < 10; i++ {
+		input := gimei.NewName().Kanji()
+		if p.Name(input).Kanji() == q.Name(input).Kanji() {
+			same++
+		}
+	}
+	if same == 10 {
+		t.Fatal("different key should map to different name")
+	}
+}
+
+func TestPseudonymizerKeepSex(t *testing.T) {
+	p := gimei.NewPseudonymizer([]byte("secret"))
+	for i := 0; i < 50; i++ {
+		for _, real := range []*gimei.Name{gimei.NewMale(), gimei.NewFemale()} {
+			found := gimei.FindNameByKanji(real.Kanji())
+			if found == nil {
+				t.Fatalf("%s should be found", real)
+			}
+			if fake := p.Name(real.Kanji()); fake.Sex != found.Sex {
+				t.Fatalf("sex of %s should be %v but %v", fake, found.Sex, fake.Sex)
+			}
+		}
+	}
+}
+
+func BenchmarkPseudonymizer(b *testing.B) {
+	p := gimei.NewPseudonymizer([]byte("secret"))
+	for i := 0; i < b.N; i++ {
+		p.Name("customer")
+	}
+}
@@ -0,0 +1,150 @@
+package gimei
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+// Generate implement quick.Generator. Use Name rather than *Name as argument
+// of property function.
+func (Name) Generate(rnd *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*newPicker(rnd).name())
+}
+
+// Generate implement quick.Generator. Use Address rather than *Address as
+// argument of property function.
+func (Address) Generate(rnd *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*newPicker(rnd).address())
+}
+
+// Generate implement quick.Generator. Use PostalCode rather than *PostalCode
+// as argument of property function.
+func (PostalCode) Generate(rnd *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*newPicker(rnd).postalCode())
+}
+
+// Corpus is seed corpus of fuzzing such as *testing.F.
+type Corpus interface {
+	Add(args ...interface{})
+}
+
+// SeedNames add kanji, hiragana, katakana and romaji of representative names
+// to corpus. They are the shortest and the longest names, names with unusual
+// letters such as 々, ゑ or ヴ, gender-neutral names and names of foreign
+// residents.
+func SeedNames(f Corpus) {
+	for _, n := range representativeNames() {
+		f.Add(n.Kanji())
+		f.Add(n.Hiragana())
+		f.Add(n.Katakana())
+		f.Add(n.Romaji())
+	}
+}
+
+// SeedAddresses add kanji, hiragana and katakana of representative addresses
+// to corpus. They are the shortest and the longest addresses, and addresses
+// with 郡, 区, ヶ or remote islands.
+func SeedAddresses(f Corpus) {
+	for _, a := range representativeAddresses() {
+		f.Add(a.Kanji())
+		f.Add(a.Hiragana())
+		f.Add(a.Katakana())
+	}
+}
+
+func representativeNames() []*Name {
+	onceName.Do(loadNames)
+	onceForeignName.Do(loadForeignNames)
+
+	first := append(append([]Item(nil), names.FirstName.Male...), names.FirstName.Female...)
+	last := names.LastName
+	list := []*Name{
+		{Last: shortestItem(last), First: shortestItem(first)},
+		{Last: longestItem(last), First: longestItem(first)},
+		{Last: last[0], First: names.FirstName.Neutral[0]},
+	}
+	for _, s := range []string{"々", "ゑ", "ゐ", "を", "ヴ", "ヶ", "ー"} {
+		if item := findItemContains(first, s); item != nil {
+			list = append(list, &Name{Last: last[0], First: item})
+		}
+		if item := findItemContains(last, s); item != nil {
+			list = append(list, &Name{Last: item, First: first[0]})
+		}
+	}
+	western := foreignNames[Western]
+	list = append(list,
+		// long katakana name with middle name
+		&Name{Last: longestItem(western.last), First: Item{
+			longestItem(western.male).Kanji() + " " + western.male[0].Kanji(),
+			longestItem(western.male).Hiragana() + " " + western.male[0].Hiragana(),
+			longestItem(western.male).Katakana() + " " + western.male[0].Katakana(),
+			western.male[0].Romaji() + " " + longestItem(western.male).Romaji(),
+		}},
+		&Name{Last: foreignNames[Chinese].last[0], First: foreignNames[Chinese].female[0]},
+		&Name{Last: foreignNames[Korean].last[0], First: foreignNames[Korean].male[0]},
+	)
+	return list
+}
+
+func representativeAddresses() []*Address {
+	onceAddress.Do(loadAddresses)
+
+	list := []*Address{
+		{Prefecture: shortestItem(addresses.Addresses.Prefecture), City: shortestItem(addresses.Addresses.City), Town: shortestItem(addresses.Addresses.Town)},
+		{Prefecture: longestItem(addresses.Addresses.Prefecture), City: longestItem(addresses.Addresses.City), Town: longestItem(addresses.Addresses.Town)},
+	}
+	for _, s := range []string{"郡", "区", "ヶ", "ケ"} {
+		if i := indexOfItemContains(addresses.Addresses.City, s); i >= 0 {
+			list = append(list, &Address{Prefecture: cityPrefecture[i], City: addresses.Addresses.City[i], Town: addresses.Addresses.Town[0]})
+		}
+		if item := findItemContains(addresses.Addresses.Town, s); item != nil {
+			list = append(list, &Address{Prefecture: cityPrefecture[0], City: addresses.Addresses.City[0], Town: item})
+		}
+	}
+	for i, city := range addresses.Addresses.City {
+		if islandCities[city.Kanji()] {
+			list = append(list, &Address{Prefecture: cityPrefecture[i], City: city, Town: addresses.Addresses.Town[0]})
+			break
+		}
+	}
+	return list
+}
+
+func shortestItem(items []Item) Item {
+	found := items[0]
+	for _, item := range items {
+		if len([]rune(item.Kanji())) < len([]rune(found.Kanji())) {
+			found = item
+		}
+	}
+	return found
+}
+
+func longestItem(items []Item) Item {
+	found := items[0]
+	for _, item := range items {
+		if len([]rune(item.Katakana())) > len([]rune(found.Katakana())) {
+			found = item
+		}
+	}
+	return found
+}
+
+func findItemContains(items []Item, s string) Item {
+	if i := indexOfItemContains(items, s); i >= 0 {
+		return items[i]
+	}
+	return nil
+}
+
+func indexOfItemContains(items []Item, s string) int {
+	for i, item := range items {
+		for _, v := range item {
+			if strings.Contains(v, s) {
+				return i
+			}
+		}
+	}
+	return -1
+}
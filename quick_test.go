@@ -0,0 +1,78 @@
+package gimei_test
+
+import (
+	"strings"
+	"testing"
+	"testing/quick"
+	"unicode/utf8"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestQuickGenerator(t *testing.T) {
+	f := func(n gimei.Name, a gimei.Address, p gimei.PostalCode) bool {
+		return gimei.FindNameByKanji(n.Kanji()) != nil &&
+			strings.HasPrefix(a.Kanji(), a.Prefecture.Kanji()) &&
+			len(p.Kanji()) == 8
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type corpus []string
+
+func (c *corpus) Add(args ...interface{}) {
+	for _, arg := range args {
+		*c = append(*c, arg.(string))
+	}
+}
+
+func TestSeedNames(t *testing.T) {
+	var c corpus
+	gimei.SeedNames(&c)
+	if len(c) == 0 {
+		t.Fatal("corpus should not be empty")
+	}
+	var hasLong, hasMiddle bool
+	for _, s := range c {
+		if !utf8.ValidString(s) || s == "" {
+			t.Fatalf("invalid seed: %q", s)
+		}
+		if utf8.RuneCountInString(s) > 15 {
+			hasLong = true
+		}
+		if strings.Count(s, " ") == 2 {
+			hasMiddle = true
+		}
+	}
+	if !hasLong || !hasMiddle {
+		t.Fatalf("corpus should have long names and middle names: %q", c)
+	}
+}
+
+func TestSeedAddresses(t *testing.T) {
+	var c corpus
+	gimei.SeedAddresses(&c)
+	var hasCounty bool
+	for _, s := range c {
+		if s == "" {
+			t.Fatalf("invalid seed: %q", c)
+		}
+		if strings.Contains(s, "郡") {
+			hasCounty = true
+		}
+	}
+	if !hasCounty {
+		t.Fatalf("corpus should have county: %q", c)
+	}
+}
+
+func FuzzFindNameByKanji(f *testing.F) {
+	gimei.SeedNames(f)
+	f.Fuzz(func(t *testing.T, s string) {
+		if n := gimei.FindNameByKanji(s); n != nil && n.Kanji() != s {
+			t.Fatalf("want %q but %q", s, n.Kanji())
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package gimei
+
+import (
+	"html"
+)
+
+// RubyOption change output of Ruby.
+type RubyOption int
+
+// list of ruby option
+const (
+	RubyKatakana RubyOption = iota + 1 // reading in katakana instead of hiragana
+	RubyPlain                          // plain text such as 小林(こばやし) instead of HTML
+)
+
+// Ruby return HTML ruby markup of Item such as
+// <ruby>小林<rt>こばやし</rt></ruby>. Reading is omitted if it is same as
+// kanji.
+func (i Item) Ruby(opts ...RubyOption) string {
+	katakana, plain := false, false
+	for _, opt := range opts {
+		switch opt {
+		case RubyKatakana:
+			katakana = true
+		case RubyPlain:
+			plain = true
+		}
+	}
+	base, reading := i.Kanji(), i.Hiragana()
+	if katakana {
+		reading = i.Katakana()
+	}
+	if plain {
+		if reading == "" || reading == base {
+			return base
+		}
+		return base + "(" + reading + ")"
+	}
+	if reading == "" || reading == base {
+		return html.EscapeString(base)
+	}
+	return "<ruby>" + html.EscapeString(base) + "<rt>" + html.EscapeString(reading) + "</rt></ruby>"
+}
+
+// Ruby return HTML ruby markup of Name for each of last name and first name.
+func (n *Name) Ruby(opts ...RubyOption) string {
+	return n.Last.Ruby(opts...) + " " + n.First.Ruby(opts...)
+}
+
+// Ruby return HTML ruby markup of Address for each of prefecture, city and
+// town.
+func (a *Address) Ruby(opts ...RubyOption) string {
+	return a.Prefecture.Ruby(opts...) + a.City.Ruby(opts...) + a.Town.Ruby(opts...)
+}
@@ -0,0 +1,39 @@
+package gimei_test
+
+import (
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestRuby(t *testing.T) {
+	name := &gimei.Name{
+		First: gimei.Item{"顕士", "けんじ", "ケンジ", "kenji"},
+		Last:  gimei.Item{"小林", "こばやし", "コバヤシ", "kobayashi"},
+	}
+	address := &gimei.Address{
+		Prefecture: gimei.Item{"岡山県", "おかやまけん", "オカヤマケン"},
+		City:       gimei.Item{"岡山市", "おかやまし", "オカヤマシ"},
+		Town:       gimei.Item{"稲木町", "いなぎちょう", "イナギチョウ"},
+	}
+	tests := []struct {
+		got  string
+		want string
+	}{
+		{name.Last.Ruby(), "<ruby>小林<rt>こばやし</rt></ruby>"},
+		{name.Ruby(), "<ruby>小林<rt>こばやし</rt></ruby> <ruby>顕士<rt>けんじ</rt></ruby>"},
+		{name.Ruby(gimei.RubyKatakana), "<ruby>小林<rt>コバヤシ</rt></ruby> <ruby>顕士<rt>ケンジ</rt></ruby>"},
+		{name.Ruby(gimei.RubyPlain), "小林(こばやし) 顕士(けんじ)"},
+		{name.Ruby(gimei.RubyPlain, gimei.RubyKatakana), "小林(コバヤシ) 顕士(ケンジ)"},
+		{address.Ruby(), "<ruby>岡山県<rt>おかやまけん</rt></ruby><ruby>岡山市<rt>おかやまし</rt></ruby><ruby>稲木町<rt>いなぎちょう</rt></ruby>"},
+		{address.Ruby(gimei.RubyPlain), "岡山県(おかやまけん)岡山市(おかやまし)稲木町(いなぎちょう)"},
+		{gimei.Item{"スミス", "すみす", "スミス", "smith"}.Ruby(gimei.RubyKatakana), "スミス"},
+		{gimei.Item{"<b>", "&"}.Ruby(), "<ruby>&lt;b&gt;<rt>&amp;</rt></ruby>"},
+		{gimei.Item(nil).Ruby(), ""},
+	}
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Fatalf("want %q but %q", test.want, test.got)
+		}
+	}
+}
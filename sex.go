@@ -0,0 +1,89 @@
+package gimei
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ISO5218 return code of Sex in ISO/IEC 5218.
+func (s Sex) ISO5218() int {
+	return int(s)
+}
+
+// English return English label of Sex in ISO/IEC 5218 such as "male".
+func (s Sex) English() string {
+	switch s {
+	case Male:
+		return "male"
+	case Female:
+		return "female"
+	case Other:
+		return "not applicable"
+	}
+	return "not known"
+}
+
+// ParseSex return Sex from ISO/IEC 5218 code such as "1", English label such
+// as "female" or Japanese label such as "男". It return false if s is unknown.
+func ParseSex(s string) (Sex, bool) {
+	if n, err := strconv.Atoi(s); err == nil {
+		switch sex := Sex(n); sex {
+		case Unspecified, Male, Female, Other:
+			return sex, true
+		}
+		return Unspecified, false
+	}
+	for _, sex := range []Sex{Unspecified, Male, Female, Other} {
+		if strings.EqualFold(s, sex.English()) || s == sex.String() {
+			return sex, true
+		}
+	}
+	return Unspecified, false
+}
+
+// SetSexRatio set ratio of male, female and other that NewName picks. Other is
+// given a gender-neutral name. The default is 1:1:0.
+func SetSexRatio(male, female, other int) {
+	checkSexRatio(male, female, other)
+	updateSettings(func(s *settings) {
+		s.sexRatio = [3]int{male, female, other}
+	})
+}
+
+func checkSexRatio(male, female, other int) {
+	if male < 0 || female < 0 || other < 0 || male+female+other == 0 {
+		panic("invalid sex ratio")
+	}
+}
+
+// IsNeutral return true if first name is used for both male and female such
+// as 薫 or 翼.
+func (n *Name) IsNeutral() bool {
+	onceName.Do(loadNames)
+	return neutralFirstNames[n.First.Kanji()+" "+n.First.Hiragana()]
+}
+
+// NewNeutralName return new instance of person who has gender-neutral first
+// name. Sex is picked by the ratio set by SetSexRatio.
+func NewNeutralName() *Name {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return global().neutralName()
+}
+
+// NewNeutralName return new instance of person who has gender-neutral first
+// name. Sex is picked by the ratio of the generator.
+func (g *Generator) NewNeutralName() *Name {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().neutralName()
+}
+
+func (p picker) neutralName() *Name {
+	onceName.Do(loadNames)
+	first := names.FirstName.Neutral[p.rand.Intn(len(names.FirstName.Neutral))]
+	last := names.LastName[p.rand.Intn(len(names.LastName))]
+	return &Name{First: first, Last: last, Sex: p.sex()}
+}
@@ -0,0 +1,72 @@
+package gimei_test
+
+import (
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestSexISO5218(t *testing.T) {
+	tests := []struct {
+		sex     gimei.Sex
+		code    int
+		english string
+	}{
+		{gimei.Unspecified, 0, "not known"},
+		{gimei.Male, 1, "male"},
+		{gimei.Female, 2, "female"},
+		{gimei.Other, 9, "not applicable"},
+	}
+	for _, test := range tests {
+		if got := test.sex.ISO5218(); got != test.code {
+			t.Fatalf("ISO5218 of %s: want %d but %d", test.sex, test.code, got)
+		}
+		if got := test.sex.English(); got != test.english {
+			t.Fatalf("English of %s: want %q but %q", test.sex, test.english, got)
+		}
+		for _, s := range []string{test.english, test.sex.String(), string(rune('0' + test.code))} {
+			if got, ok := gimei.ParseSex(s); !ok || got != test.sex {
+				t.Fatalf("ParseSex(%q): want %s but %s", s, test.sex, got)
+			}
+		}
+	}
+	for _, s := range []string{"3", "", "unknown"} {
+		if _, ok := gimei.ParseSex(s); ok {
+			t.Fatalf("ParseSex(%q) should fail", s)
+		}
+	}
+}
+
+func TestNewNeutralName(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		name := gimei.NewNeutralName()
+		if !name.IsNeutral() {
+			t.Fatalf("name should be neutral: %s", name)
+		}
+		if gimei.FindNameByKanji(name.Kanji()) == nil {
+			t.Fatalf("FindNameByKanji not found: %s", name)
+		}
+	}
+	if name := gimei.FindNameByKanji("佐藤 翼"); name == nil || !name.IsNeutral() {
+		t.Fatalf("佐藤 翼 should be neutral: %v", name)
+	}
+}
+
+func TestSetSexRatio(t *testing.T) {
+	defer gimei.SetSexRatio(1, 1, 0)
+
+	gimei.SetSexRatio(0, 0, 1)
+	for i := 0; i < 100; i++ {
+		name := gimei.NewName()
+		if name.Sex != gimei.Other || !name.IsNeutral() {
+			t.Fatalf("name should be other with neutral name: %s (%s)", name, name.Sex)
+		}
+	}
+
+	gimei.SetSexRatio(0, 1, 0)
+	for i := 0; i < 100; i++ {
+		if name := gimei.NewNameIn("東京都"); !name.IsFemale() {
+			t.Fatalf("name should be female: %s", name)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package gimei
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	mathrand "math/rand"
+)
+
+// Source is source of random values. It is same as rand.Source of
+// math/rand/v2, so rand.NewPCG, rand.NewChaCha8 and *rand.Rand of math/rand/v2
+// can be used as Source. *rand.Rand of math/rand is also a Source.
+type Source interface {
+	Uint64() uint64
+}
+
+// source64 adapt Source to rand.Source64 of math/rand.
+type source64 struct {
+	src Source
+}
+
+func (s source64) Uint64() uint64 {
+	return s.src.Uint64()
+}
+
+func (s source64) Int63() int64 {
+	return int64(s.src.Uint64() >> 1)
+}
+
+// Seed does nothing. Seed the Source itself instead.
+func (s source64) Seed(int64) {}
+
+func newRand(src Source) *mathrand.Rand {
+	if r, ok := src.(*mathrand.Rand); ok {
+		return r
+	}
+	return mathrand.New(source64{src})
+}
+
+// SetSource set Source that uses to generate random values.
+//
+//	gimei.SetSource(rand.NewPCG(1, 2)) // math/rand/v2
+func SetSource(src Source) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	r = newRand(src)
+}
+
+// NewGeneratorFromSource return new instance of Generator that uses src.
+func NewGeneratorFromSource(src Source) *Generator {
+	return NewGenerator(newRand(src))
+}
+
+// cryptoSource is Source backed by crypto/rand.
+type cryptoSource struct{}
+
+func (cryptoSource) Uint64() uint64 {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return binary.BigEndian.Uint64(b[:])
+}
+
+// CryptoSource return Source backed by crypto/rand. Generated data can not be
+// guessed from other generated data, so it can be used for identifiers of test
+// accounts. It is slower than other sources and can not be seeded.
+func CryptoSource() Source {
+	return cryptoSource{}
+}
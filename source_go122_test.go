@@ -0,0 +1,34 @@
+//go:build go1.22
+// +build go1.22
+
+package gimei_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestSetSourceV2(t *testing.T) {
+	for _, src := range []func() gimei.Source{
+		func() gimei.Source { return rand.NewPCG(1, 2) },
+		func() gimei.Source { return rand.NewChaCha8([32]byte{1}) },
+		func() gimei.Source { return rand.New(rand.NewPCG(1, 2)) },
+	} {
+		g1, g2 := gimei.NewGeneratorFromSource(src()), gimei.NewGeneratorFromSource(src())
+		for i := 0; i < 10; i++ {
+			if n1, n2 := g1.NewName(), g2.NewName(); n1.Kanji() != n2.Kanji() {
+				t.Fatalf("same source should generate same name: %s != %s", n1, n2)
+			}
+		}
+	}
+}
+
+func BenchmarkPCG(b *testing.B) {
+	benchmarkGenerator(b, gimei.NewGeneratorFromSource(rand.NewPCG(1, 2)))
+}
+
+func BenchmarkChaCha8(b *testing.B) {
+	benchmarkGenerator(b, gimei.NewGeneratorFromSource(rand.NewChaCha8([32]byte{1})))
+}
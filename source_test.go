@@ -0,0 +1,64 @@
+package gimei_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+// counter is Source that return sequential values.
+type counter uint64
+
+func (c *counter) Uint64() uint64 {
+	*c += 0x9e3779b97f4a7c15
+	return uint64(*c)
+}
+
+func TestSetSource(t *testing.T) {
+	defer gimei.SetRandom(rand.New(rand.NewSource(rand.Int63())))
+
+	var c1 counter
+	gimei.SetSource(&c1)
+	want := collectNewResults()
+
+	var c2 counter
+	gimei.SetSource(&c2)
+	got := collectNewResults()
+	for i := range want {
+		if got[i].String() != want[i].String() {
+			t.Errorf("got[%d] == %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	var c3 counter
+	g := gimei.NewGeneratorFromSource(&c3)
+	if name := g.NewName(); name.Kanji() != want[0].String() {
+		t.Errorf("want %q but %q", want[0], name)
+	}
+}
+
+func TestCryptoSource(t *testing.T) {
+	g := gimei.NewGeneratorFromSource(gimei.CryptoSource())
+	seen := map[string]bool{}
+	for i := 0; i < 10; i++ {
+		seen[g.NewName().Kanji()] = true
+	}
+	if len(seen) < 2 {
+		t.Fatal("crypto source should generate different names")
+	}
+}
+
+func benchmarkGenerator(b *testing.B, g *gimei.Generator) {
+	for i := 0; i < b.N; i++ {
+		g.NewName()
+	}
+}
+
+func BenchmarkMathRand(b *testing.B) {
+	benchmarkGenerator(b, gimei.NewGenerator(rand.New(rand.NewSource(1))))
+}
+
+func BenchmarkCryptoSource(b *testing.B) {
+	benchmarkGenerator(b, gimei.NewGeneratorFromSource(gimei.CryptoSource()))
+}
@@ -0,0 +1,147 @@
+package gimei
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+// SQLFormat is format of value stored in database.
+type SQLFormat int
+
+// list of sql format
+const (
+	SQLKanji SQLFormat = iota // kanji string that is looked up on scan (lossy)
+	SQLJSON                   // JSON with named fields
+)
+
+var sqlFormat = SQLKanji
+
+// SetSQLFormat set format of value that Item, Name, Address, Prefecture and
+// ForeignName store in database. The default is SQLKanji. Scan accepts both
+// formats.
+//
+// SQLKanji is lossy because only kanji is stored. On scan, the reading of
+// kanji that has several readings may be another one, Sex is guessed from the
+// first name, so Other is never restored and 薫 may become male, and History
+// is lost. Use SQLJSON to restore the value as it is.
+func SetSQLFormat(f SQLFormat) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	sqlFormat = f
+}
+
+func sqlValue(v interface {
+	json.Marshaler
+	encoding.TextMarshaler
+}) (driver.Value, error) {
+	mu.Lock()
+	f := sqlFormat
+	mu.Unlock()
+
+	var b []byte
+	var err error
+	if f == SQLJSON {
+		b, err = v.MarshalJSON()
+	} else {
+		b, err = v.MarshalText()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func sqlScan(v interface {
+	json.Unmarshaler
+	encoding.TextUnmarshaler
+}, src interface{}) error {
+	var b []byte
+	switch s := src.(type) {
+	case string:
+		b = []byte(s)
+	case []byte:
+		b = s
+	default:
+		return fmt.Errorf("gimei: cannot scan %T", src)
+	}
+	if len(b) > 0 && (b[0] == '{' || b[0] == '[') {
+		return v.UnmarshalJSON(b)
+	}
+	return v.UnmarshalText(b)
+}
+
+// Value implement driver.Valuer.
+func (i Item) Value() (driver.Value, error) {
+	if i == nil {
+		return nil, nil
+	}
+	return sqlValue(i)
+}
+
+// Scan implement sql.Scanner.
+func (i *Item) Scan(src interface{}) error {
+	if src == nil {
+		*i = nil
+		return nil
+	}
+	return sqlScan(i, src)
+}
+
+// Value implement driver.Valuer.
+func (n Name) Value() (driver.Value, error) {
+	return sqlValue(n)
+}
+
+// Scan implement sql.Scanner.
+func (n *Name) Scan(src interface{}) error {
+	if src == nil {
+		*n = Name{}
+		return nil
+	}
+	return sqlScan(n, src)
+}
+
+// Value implement driver.Valuer.
+func (a Address) Value() (driver.Value, error) {
+	return sqlValue(a)
+}
+
+// Scan implement sql.Scanner.
+func (a *Address) Scan(src interface{}) error {
+	if src == nil {
+		*a = Address{}
+		return nil
+	}
+	return sqlScan(a, src)
+}
+
+// Value implement driver.Valuer.
+func (f ForeignName) Value() (driver.Value, error) {
+	return sqlValue(f)
+}
+
+// Scan implement sql.Scanner.
+func (f *ForeignName) Scan(src interface{}) error {
+	if src == nil {
+		*f = ForeignName{}
+		return nil
+	}
+	return sqlScan(f, src)
+}
+
+// Value implement driver.Valuer.
+func (p Prefecture) Value() (driver.Value, error) {
+	return sqlValue(p)
+}
+
+// Scan implement sql.Scanner.
+func (p *Prefecture) Scan(src interface{}) error {
+	if src == nil {
+		*p = Prefecture{}
+		return nil
+	}
+	return sqlScan(p, src)
+}
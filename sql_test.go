@@ -0,0 +1,156 @@
+package gimei_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-gimei"
+)
+
+var (
+	_ driver.Valuer = gimei.Name{}
+	_ sql.Scanner   = (*gimei.Name)(nil)
+	_ driver.Valuer = gimei.Address{}
+	_ sql.Scanner   = (*gimei.Address)(nil)
+	_ driver.Valuer = gimei.Item{}
+	_ sql.Scanner   = (*gimei.Item)(nil)
+	_ driver.Valuer = gimei.Prefecture{}
+	_ sql.Scanner   = (*gimei.Prefecture)(nil)
+)
+
+func TestSQLKanji(t *testing.T) {
+	name := gimei.NewName()
+	v, err := name.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != name.Kanji() {
+		t.Fatalf("want %q but %q", name.Kanji(), v)
+	}
+	var got gimei.Name
+	for _, src := range []interface{}{v, []byte(v.(string))} {
+		if err := got.Scan(src); err != nil {
+			t.Fatal(err)
+		}
+		// SQLKanji is lossy: reading may differ if kanji has several readings
+		if got.Kanji() != name.Kanji() || got.Katakana() == "" {
+			t.Fatalf("want %s but %s", name, &got)
+		}
+	}
+
+	address := gimei.NewAddress()
+	v, err = address.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotAddress gimei.Address
+	if err := gotAddress.Scan(v); err != nil {
+		t.Fatal(err)
+	}
+	if gotAddress.Kanji() != address.Kanji() {
+		t.Fatalf("want %s but %s", address, &gotAddress)
+	}
+
+	prefecture := gimei.FindPrefectureByCode("33")
+	v, err = prefecture.Value()
+	if err != nil || v != "岡山県" {
+		t.Fatalf("want 岡山県 but %q (%v)", v, err)
+	}
+	var gotPrefecture gimei.Prefecture
+	if err := gotPrefecture.Scan(v); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&gotPrefecture, prefecture) {
+		t.Fatalf("want %#v but %#v", prefecture, &gotPrefecture)
+	}
+
+	if err := got.Scan(nil); err != nil || got.Last != nil {
+		t.Fatalf("scan nil should be zero value: %v", err)
+	}
+	if err := got.Scan(1); err == nil {
+		t.Fatal("scan int should be error")
+	}
+	if err := got.Scan("偽 名"); err == nil {
+		t.Fatal("scan unknown name should be error")
+	}
+}
+
+func TestSQLJSON(t *testing.T) {
+	gimei.SetSQLFormat(gimei.SQLJSON)
+	defer gimei.SetSQLFormat(gimei.SQLKanji)
+
+	name := gimei.NewNameWithMaidenName()
+	v, err := name.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(v.(string), `{"first":`) {
+		t.Fatalf("value should be JSON: %v", v)
+	}
+	var got gimei.Name
+	if err := got.Scan(v); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, name) {
+		t.Fatalf("want %#v but %#v", name, &got)
+	}
+
+	item := gimei.Item{"岡山県", "おかやまけん", "オカヤマケン"}
+	v, err = item.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotItem gimei.Item
+	if err := gotItem.Scan(v); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotItem, item) {
+		t.Fatalf("want %v but %v", item, gotItem)
+	}
+
+	// kanji is accepted in JSON mode too
+	if err := gotItem.Scan("岡山県"); err != nil || gotItem.Katakana() != "オカヤマケン" {
+		t.Fatalf("scan kanji should look up dictionary: %v %v", gotItem, err)
+	}
+}
+
+func TestSQLLossless(t *testing.T) {
+	sato := gimei.FindNameByKanji("佐藤 薫").Last
+	name := &gimei.Name{
+		Last:    sato,
+		First:   gimei.Item{"薫", "かおり", "カオリ", "kaori"},
+		Sex:     gimei.Other,
+		History: []gimei.NameChange{{Last: gimei.FindNameByKanji("鈴木 薫").Last, Date: time.Date(2010, time.April, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	// SQLKanji lose reading, Sex and History
+	v, err := name.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got gimei.Name
+	if err := got.Scan(v); err != nil {
+		t.Fatal(err)
+	}
+	if got.Kanji() != name.Kanji() || got.Sex == gimei.Other || got.History != nil {
+		t.Fatalf("SQLKanji should keep only kanji: %#v", got)
+	}
+
+	gimei.SetSQLFormat(gimei.SQLJSON)
+	defer gimei.SetSQLFormat(gimei.SQLKanji)
+	v, err = name.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = gimei.Name{}
+	if err := got.Scan(v); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, name) {
+		t.Fatalf("SQLJSON should keep reading, Sex and History: want %#v but %#v", name, &got)
+	}
+}
@@ -0,0 +1,103 @@
+package gimei
+
+import (
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	surnames    map[string][]surname
+	onceSurname sync.Once
+)
+
+// surnameData store data sturecture just same as surnames.yml.
+type surnameData struct {
+	Surnames map[string][]struct {
+		Name  string `yaml:"name"`
+		Share int    `yaml:"share"`
+	} `yaml:"surnames"`
+}
+
+// surname store common surname and the share (per mille) in a prefecture.
+type surname struct {
+	item  Item
+	share int
+}
+
+func loadSurnames() {
+	onceName.Do(loadNames)
+	var data surnameData
+	if b, err := assets.ReadFile("data/surnames.yml"); err == nil {
+		if err = yaml.Unmarshal(b, &data); err == nil {
+			surnames = make(map[string][]surname, len(data.Surnames))
+			for prefecture, list := range data.Surnames {
+				for _, s := range list {
+					item, ok := lastNameIndex[0][s.Name]
+					if !ok {
+						panic("unknown surname in surnames data: " + s.Name)
+					}
+					surnames[prefecture] = append(surnames[prefecture], surname{item: item, share: s.Share})
+				}
+			}
+			return
+		}
+	}
+	panic("failed to load surnames data")
+}
+
+// surname return surname that is common in the prefecture by its share.
+// Other surnames are picked uniformly.
+func (p picker) surname(prefecture string) Item {
+	onceSurname.Do(loadSurnames)
+	n := p.rand.Intn(1000)
+	for _, s := range surnames[prefecture] {
+		if n < s.share {
+			return s.item
+		}
+		n -= s.share
+	}
+	return names.LastName[p.rand.Intn(len(names.LastName))]
+}
+
+// nameIn return new instance of person who lives in the prefecture.
+func (p picker) nameIn(prefecture string) *Name {
+	onceSurname.Do(loadSurnames)
+	sex := p.sex()
+	return &Name{
+		First: p.givenName(sex),
+		Last:  p.surname(prefecture),
+		Sex:   sex,
+	}
+}
+
+// NewNameIn return new instance of person who lives in the prefecture. Last
+// name is picked by frequency of surnames in the prefecture, so 比嘉 or 金城
+// are often picked in 沖縄県.
+func NewNameIn(prefecture string) *Name {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return global().nameIn(prefecture)
+}
+
+// NewNameAt return new instance of person who lives at the address. See
+// NewNameIn.
+func NewNameAt(address *Address) *Name {
+	return NewNameIn(address.Prefecture.Kanji())
+}
+
+// NewNameIn return new instance of person who lives in the prefecture. See
+// NewNameIn.
+func (g *Generator) NewNameIn(prefecture string) *Name {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.picker().nameIn(prefecture)
+}
+
+// NewNameAt return new instance of person who lives at the address. See
+// NewNameIn.
+func (g *Generator) NewNameAt(address *Address) *Name {
+	return g.NewNameIn(address.Prefecture.Kanji())
+}
@@ -0,0 +1,34 @@
+package gimei_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestNewNameIn(t *testing.T) {
+	gimei.SetRandom(rand.New(rand.NewSource(42)))
+
+	okinawa := map[string]bool{
+		"比嘉": true, "金城": true, "大城": true, "宮城": true, "新垣": true,
+		"玉城": true, "上原": true, "島袋": true, "平良": true, "山城": true,
+	}
+	var inOkinawa, inTokyo int
+	for i := 0; i < 1000; i++ {
+		name := gimei.NewNameAt(gimei.NewAddressIn("沖縄県"))
+		if okinawa[name.Last.Kanji()] {
+			inOkinawa++
+		}
+		if gimei.FindNameByKanji(name.Kanji()) == nil {
+			t.Fatalf("FindNameByKanji not found: %s", name)
+		}
+		if okinawa[gimei.NewNameIn("東京都").Last.Kanji()] {
+			inTokyo++
+		}
+	}
+	// about 15% in 沖縄県 and less than 1% in 東京都
+	if inOkinawa < 100 || inTokyo > 50 {
+		t.Errorf("surnames of 沖縄 are picked %d times in 沖縄県 and %d times in 東京都", inOkinawa, inTokyo)
+	}
+}
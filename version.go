@@ -0,0 +1,63 @@
+package gimei
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"sort"
+	"sync"
+)
+
+// DataVersion is version of the embedded datasets. It is bumped whenever the
+// datasets are changed, so the data generated with the same seed is same as
+// long as DataVersion is same.
+const DataVersion = "2026.3"
+
+var (
+	dataChecksum string
+	onceChecksum sync.Once
+)
+
+// DataChecksum return SHA-256 checksum of the embedded datasets as hex string.
+// Line endings are normalized to LF before hashing.
+func DataChecksum() string {
+	onceChecksum.Do(func() {
+		var files []string
+		err := fs.WalkDir(assets, "data", func(path string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() {
+				files = append(files, path)
+			}
+			return err
+		})
+		if err != nil {
+			panic(err)
+		}
+		sort.Strings(files)
+		h := sha256.New()
+		for _, file := range files {
+			b, err := assets.ReadFile(file)
+			if err != nil {
+				panic(err)
+			}
+			// same checksum even if line endings are converted on checkout
+			b = bytes.ReplaceAll(b, []byte("\r\n"), []byte("\n"))
+			fmt.Fprintf(h, "%s\x00%d\x00", file, len(b))
+			h.Write(b)
+		}
+		dataChecksum = hex.EncodeToString(h.Sum(nil))
+	})
+	return dataChecksum
+}
+
+// CheckDataVersion return error if the embedded datasets are not the version.
+// Call it in tests that compare generated data with golden files, so that the
+// tests fail with clear message after upgrading to the library with another
+// datasets. Only the embedded version is available.
+func CheckDataVersion(version string) error {
+	if version != DataVersion {
+		return fmt.Errorf("gimei: data version is %s, not %s", DataVersion, version)
+	}
+	return nil
+}
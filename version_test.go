@@ -0,0 +1,85 @@
+package gimei_test
+
+import (
+	"encoding/json"
+	"flag"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-gimei"
+)
+
+var update = flag.Bool("update", false, "update golden files of compatibility test")
+
+// compat is golden file of data generated with the version of datasets.
+type compat struct {
+	Version  string   `json:"version"`
+	Checksum string   `json:"checksum"`
+	Seed     int64    `json:"seed"`
+	Random   []string `json:"random"` // generated after SetRandom
+	Index    []string `json:"index"`  // generated by NameForIndex and AddressForIndex
+}
+
+func generateCompat(seed int64) *compat {
+	c := &compat{Version: gimei.DataVersion, Checksum: gimei.DataChecksum(), Seed: seed}
+	gimei.SetRandom(rand.New(rand.NewSource(seed)))
+	defer gimei.SetRandom(rand.New(rand.NewSource(rand.Int63())))
+	for i := 0; i < 5; i++ {
+		for _, s := range collectNewResults() {
+			c.Random = append(c.Random, s.String())
+		}
+		// fixed date so that the golden file does not change every year
+		at := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+		c.Random = append(c.Random, gimei.NewHouseholdAt(at).String(), gimei.NewNameWithMaidenNameAt(at).KanjiWithMaidenName())
+		c.Index = append(c.Index, gimei.NameForIndex(seed, i).String(), gimei.AddressForIndex(seed, i).String())
+	}
+	return c
+}
+
+func TestCompatibility(t *testing.T) {
+	file := filepath.Join("testdata", "compat", gimei.DataVersion+".json")
+	if *update {
+		b, err := json.MarshalIndent(generateCompat(42), "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, append(b, '\n'), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("golden file of data version %s is missing: run go test -run TestCompatibility -update", gimei.DataVersion)
+	}
+	var want compat
+	if err := json.Unmarshal(b, &want); err != nil {
+		t.Fatal(err)
+	}
+	if want.Checksum != gimei.DataChecksum() {
+		t.Fatalf("datasets are changed: bump DataVersion and run go test -run TestCompatibility -update")
+	}
+	got := generateCompat(want.Seed)
+	if !reflect.DeepEqual(got.Random, want.Random) {
+		t.Errorf("data generated by seed %d is changed:\nwant %q\ngot  %q", want.Seed, want.Random, got.Random)
+	}
+	if !reflect.DeepEqual(got.Index, want.Index) {
+		t.Errorf("data generated by index of seed %d is changed:\nwant %q\ngot  %q", want.Seed, want.Index, got.Index)
+	}
+}
+
+func TestCheckDataVersion(t *testing.T) {
+	if err := gimei.CheckDataVersion(gimei.DataVersion); err != nil {
+		t.Fatal(err)
+	}
+	if err := gimei.CheckDataVersion("1999.1"); err == nil {
+		t.Fatal("other version should be error")
+	}
+	if len(gimei.DataChecksum()) != 64 {
+		t.Fatalf("checksum should be SHA-256: %q", gimei.DataChecksum())
+	}
+}
@@ -0,0 +1,65 @@
+package gimei
+
+import (
+	"strconv"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	prefectureWeights []int64
+	cityWeights       []int64
+	onceWeight        sync.Once
+)
+
+// population store data sturecture just same as populations.yml.
+type population struct {
+	Populations [][]string `yaml:"populations"`
+}
+
+// SetPopulationWeighted set whether NewAddress, NewPrefecture, NewCity and
+// NewAddressWith pick prefecture and city weighted by the population of 2020
+// census.
+func SetPopulationWeighted(weighted bool) {
+	updateSettings(func(s *settings) {
+		s.populationWeighted = weighted
+	})
+}
+
+func loadWeights() {
+	onceMunicipalityCode.Do(loadMunicipalityCodes)
+	var data population
+	if b, err := assets.ReadFile("data/populations.yml"); err == nil {
+		if err = yaml.Unmarshal(b, &data); err == nil {
+			buildWeights(data)
+			return
+		}
+	}
+	panic("failed to load populations data")
+}
+
+func buildWeights(data population) {
+	prefectureWeights = make([]int64, len(addresses.Addresses.Prefecture))
+	for i, prefecture := range addresses.Addresses.Prefecture {
+		prefectureWeights[i] = int64(FindPrefectureByKanji(prefecture.Kanji()).Population)
+	}
+	// key is 全国地方公共団体コード of city.
+	populations := make(map[string]int64, len(data.Populations))
+	for _, entry := range data.Populations {
+		n, err := strconv.ParseInt(entry[2], 10, 64)
+		if err != nil {
+			panic("invalid population of " + entry[1] + ": " + entry[2])
+		}
+		populations[entry[0]] = n
+	}
+	cityWeights = make([]int64, len(addresses.Addresses.City))
+	for i, city := range addresses.Addresses.City {
+		prefecture := cityPrefecture[i]
+		n, ok := populations[codeByCity[prefecture.Kanji()+city.Kanji()]]
+		if !ok {
+			panic("missing city in populations data: " + prefecture.Kanji() + city.Kanji())
+		}
+		cityWeights[i] = n
+	}
+}
@@ -0,0 +1,69 @@
+package gimei_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/mattn/go-gimei"
+)
+
+func TestPopulationWeighted(t *testing.T) {
+	gimei.SetPopulationWeighted(true)
+	defer gimei.SetPopulationWeighted(false)
+
+	gimei.SetRandom(rand.New(rand.NewSource(42)))
+	prev := collectNewResults()
+	gimei.SetRandom(rand.New(rand.NewSource(42)))
+	curr := collectNewResults()
+	for i := 0; i < len(curr); i++ {
+		if prev[i].String() != curr[i].String() {
+			t.Errorf("curr[%d] == %q, want %q", i, curr[i], prev[i])
+		}
+	}
+
+	for i := 0; i < 100; i++ {
+		addr := gimei.NewAddress()
+		sameCity := func(a *gimei.Address) bool {
+			return a.City.Kanji() == addr.City.Kanji()
+		}
+		if gimei.NewAddressWith(gimei.InPrefecture(addr.Prefecture.Kanji()), sameCity) == nil {
+			t.Fatalf("city of %s is not in the prefecture", addr)
+		}
+	}
+
+	count := map[string]int{}
+	for i := 0; i < 3000; i++ {
+		count[gimei.NewPrefecture().Kanji()]++
+	}
+	// 東京都 is about 25 times as large as 鳥取県
+	if count["東京都"] < 5*count["鳥取県"] {
+		t.Errorf("東京都 == %d, 鳥取県 == %d", count["東京都"], count["鳥取県"])
+	}
+}
+
+func TestPopulationWeightedCity(t *testing.T) {
+	gimei.SetPopulationWeighted(true)
+	defer gimei.SetPopulationWeighted(false)
+
+	count := map[string]int{}
+	for i := 0; i < 3000; i++ {
+		city := gimei.NewAddressIn("神奈川県").City.Kanji()
+		if strings.HasPrefix(city, "横浜市") {
+			city = "横浜市"
+		}
+		count[city]++
+	}
+	// 横浜市 is about 1300 times as large as 清川村
+	if count["横浜市"] < 1000 || count["愛甲郡清川村"] > 10 {
+		t.Errorf("横浜市 == %d, 清川村 == %d", count["横浜市"], count["愛甲郡清川村"])
+	}
+
+	// nobody lived in 双葉町 in 2020
+	futaba := func(a *gimei.Address) bool {
+		return a.City.Kanji() == "双葉郡双葉町"
+	}
+	if addr := gimei.NewAddressWith(futaba); addr == nil || addr.City.Kanji() != "双葉郡双葉町" {
+		t.Errorf("NewAddressWith(双葉町) == %v", addr)
+	}
+}